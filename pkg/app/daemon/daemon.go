@@ -39,6 +39,7 @@ const (
 	DaemonKindInsertBreak
 	DaemonKindChangeTodoActions
 	DaemonKindMoveFixupCommitDown
+	DaemonKindMoveCommitsToBranch
 )
 
 const (
@@ -59,6 +60,7 @@ func getInstruction() Instruction {
 		DaemonKindMoveTodosUp:         deserializeInstruction[*MoveTodosUpInstruction],
 		DaemonKindMoveTodosDown:       deserializeInstruction[*MoveTodosDownInstruction],
 		DaemonKindInsertBreak:         deserializeInstruction[*InsertBreakInstruction],
+		DaemonKindMoveCommitsToBranch: deserializeInstruction[*MoveCommitsToBranchInstruction],
 	}
 
 	return mapping[getDaemonKind()](jsonData)
@@ -330,3 +332,36 @@ func (self *InsertBreakInstruction) run(common *common.Common) error {
 		return utils.PrependStrToTodoFile(path, []byte("break\n"))
 	})
 }
+
+// Takes the given commits out of the branch being rebased and applies them on
+// top of another branch instead, in the same rebase, so that conflicts can be
+// resolved as usual
+type MoveCommitsToBranchInstruction struct {
+	Shas       []string
+	BranchName string
+	BranchSha  string
+	BaseSha    string
+}
+
+func NewMoveCommitsToBranchInstruction(shas []string, branchName string, branchSha string, baseSha string) Instruction {
+	return &MoveCommitsToBranchInstruction{
+		Shas:       shas,
+		BranchName: branchName,
+		BranchSha:  branchSha,
+		BaseSha:    baseSha,
+	}
+}
+
+func (self *MoveCommitsToBranchInstruction) Kind() DaemonKind {
+	return DaemonKindMoveCommitsToBranch
+}
+
+func (self *MoveCommitsToBranchInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *MoveCommitsToBranchInstruction) run(common *common.Common) error {
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.MoveCommitsToBranch(path, self.Shas, self.BranchName, self.BranchSha, self.BaseSha, getCommentChar())
+	})
+}
@@ -97,8 +97,11 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"files":             tr.FilesTitle,
 		"status":            tr.StatusTitle,
 		"submodules":        tr.SubmodulesTitle,
+		"sparseCheckout":    tr.SparseCheckoutTitle,
 		"subCommits":        tr.SubCommitsTitle,
 		"remoteBranches":    tr.RemoteBranchesTitle,
+		"rangeDiff":         tr.RangeDiffTitle,
+		"rerereResolutions": tr.RerereResolutionsTitle,
 		"remotes":           tr.RemotesTitle,
 		"reflogCommits":     tr.ReflogCommitsTitle,
 		"tags":              tr.TagsTitle,
@@ -111,6 +114,11 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"main":              tr.NormalTitle,
 		"patchBuilding":     tr.PatchBuildingTitle,
 		"mergeConflicts":    tr.MergingTitle,
+		"mergeEditorBase":   tr.MergeEditorCheatsheetTitle,
+		"mergeEditorOurs":   tr.MergeEditorCheatsheetTitle,
+		"mergeEditorTheirs": tr.MergeEditorCheatsheetTitle,
+		"mergeEditorResult": tr.MergeEditorCheatsheetTitle,
+		"blame":             tr.BlameCheatsheetTitle,
 		"staging":           tr.StagingTitle,
 		"menu":              tr.MenuTitle,
 		"search":            tr.SearchTitle,
@@ -17,27 +17,34 @@ import (
 
 // GitCommand is our main git interface
 type GitCommand struct {
-	Blame       *git_commands.BlameCommands
-	Branch      *git_commands.BranchCommands
-	Commit      *git_commands.CommitCommands
-	Config      *git_commands.ConfigCommands
-	Custom      *git_commands.CustomCommands
-	Diff        *git_commands.DiffCommands
-	File        *git_commands.FileCommands
-	Flow        *git_commands.FlowCommands
-	Patch       *git_commands.PatchCommands
-	Rebase      *git_commands.RebaseCommands
-	Remote      *git_commands.RemoteCommands
-	Stash       *git_commands.StashCommands
-	Status      *git_commands.StatusCommands
-	Submodule   *git_commands.SubmoduleCommands
-	Sync        *git_commands.SyncCommands
-	Tag         *git_commands.TagCommands
-	WorkingTree *git_commands.WorkingTreeCommands
-	Bisect      *git_commands.BisectCommands
-	Worktree    *git_commands.WorktreeCommands
-	Version     *git_commands.GitVersion
-	RepoPaths   *git_commands.RepoPaths
+	Blame           *git_commands.BlameCommands
+	Branch          *git_commands.BranchCommands
+	Commit          *git_commands.CommitCommands
+	ConflictPreview *git_commands.ConflictPreviewCommands
+	Config          *git_commands.ConfigCommands
+	Custom          *git_commands.CustomCommands
+	Diff            *git_commands.DiffCommands
+	File            *git_commands.FileCommands
+	Flow            *git_commands.FlowCommands
+	Notes           *git_commands.NotesCommands
+	Lfs             *git_commands.LfsCommands
+	RangeDiff       *git_commands.RangeDiffCommands
+	Mailbox         *git_commands.MailboxCommands
+	Patch           *git_commands.PatchCommands
+	Rebase          *git_commands.RebaseCommands
+	Remote          *git_commands.RemoteCommands
+	Stash           *git_commands.StashCommands
+	Status          *git_commands.StatusCommands
+	Submodule       *git_commands.SubmoduleCommands
+	SparseCheckout  *git_commands.SparseCheckoutCommands
+	Rerere          *git_commands.RerereCommands
+	Sync            *git_commands.SyncCommands
+	Tag             *git_commands.TagCommands
+	WorkingTree     *git_commands.WorkingTreeCommands
+	Bisect          *git_commands.BisectCommands
+	Worktree        *git_commands.WorktreeCommands
+	Version         *git_commands.GitVersion
+	RepoPaths       *git_commands.RepoPaths
 
 	Loaders Loaders
 }
@@ -122,6 +129,8 @@ func NewGitCommandAux(
 	diffCommands := git_commands.NewDiffCommands(gitCommon)
 	fileCommands := git_commands.NewFileCommands(gitCommon)
 	submoduleCommands := git_commands.NewSubmoduleCommands(gitCommon)
+	sparseCheckoutCommands := git_commands.NewSparseCheckoutCommands(gitCommon)
+	rerereCommands := git_commands.NewRerereCommands(gitCommon)
 	workingTreeCommands := git_commands.NewWorkingTreeCommands(gitCommon, submoduleCommands, fileLoader)
 	rebaseCommands := git_commands.NewRebaseCommands(gitCommon, commitCommands, workingTreeCommands)
 	stashCommands := git_commands.NewStashCommands(gitCommon, fileLoader, workingTreeCommands)
@@ -133,6 +142,11 @@ func NewGitCommandAux(
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	notesCommands := git_commands.NewNotesCommands(gitCommon)
+	lfsCommands := git_commands.NewLfsCommands(gitCommon)
+	rangeDiffCommands := git_commands.NewRangeDiffCommands(gitCommon)
+	mailboxCommands := git_commands.NewMailboxCommands(gitCommon)
+	conflictPreviewCommands := git_commands.NewConflictPreviewCommands(gitCommon)
 
 	branchLoader := git_commands.NewBranchLoader(cmn, cmd, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
@@ -144,26 +158,33 @@ func NewGitCommandAux(
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
 	return &GitCommand{
-		Blame:       blameCommands,
-		Branch:      branchCommands,
-		Commit:      commitCommands,
-		Config:      configCommands,
-		Custom:      customCommands,
-		Diff:        diffCommands,
-		File:        fileCommands,
-		Flow:        flowCommands,
-		Patch:       patchCommands,
-		Rebase:      rebaseCommands,
-		Remote:      remoteCommands,
-		Stash:       stashCommands,
-		Status:      statusCommands,
-		Submodule:   submoduleCommands,
-		Sync:        syncCommands,
-		Tag:         tagCommands,
-		Bisect:      bisectCommands,
-		WorkingTree: workingTreeCommands,
-		Worktree:    worktreeCommands,
-		Version:     version,
+		Blame:           blameCommands,
+		Branch:          branchCommands,
+		Commit:          commitCommands,
+		ConflictPreview: conflictPreviewCommands,
+		Config:          configCommands,
+		Custom:          customCommands,
+		Diff:            diffCommands,
+		File:            fileCommands,
+		Flow:            flowCommands,
+		Notes:           notesCommands,
+		Lfs:             lfsCommands,
+		RangeDiff:       rangeDiffCommands,
+		Mailbox:         mailboxCommands,
+		Patch:           patchCommands,
+		Rebase:          rebaseCommands,
+		Remote:          remoteCommands,
+		Stash:           stashCommands,
+		Status:          statusCommands,
+		Submodule:       submoduleCommands,
+		SparseCheckout:  sparseCheckoutCommands,
+		Rerere:          rerereCommands,
+		Sync:            syncCommands,
+		Tag:             tagCommands,
+		Bisect:          bisectCommands,
+		WorkingTree:     workingTreeCommands,
+		Worktree:        worktreeCommands,
+		Version:         version,
 		Loaders: Loaders{
 			BranchLoader:       branchLoader,
 			CommitFileLoader:   commitFileLoader,
@@ -134,6 +134,32 @@ func (self *BisectCommands) StartWithTerms(oldTerm string, newTerm string) error
 	return self.cmd.New(cmdArgs).StreamOutput().Run()
 }
 
+// Lets git drive the bisect: it checks out one commit after another and runs
+// the given shell command on it, whose exit code tells whether the commit is
+// old/good (0), should be skipped (125) or is new/bad (anything else below
+// 128), until it has found the first new/bad commit.
+func (self *BisectCommands) Run(command string) error {
+	cmdArgs := NewGitCmd("bisect").
+		Arg("run", self.os.Platform.Shell, self.os.Platform.ShellArg, command).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
+// Returns the log of the current bisect session, which can be replayed later
+// (possibly by someone else) to get back to the same state
+func (self *BisectCommands) GetLog() (string, error) {
+	cmdArgs := NewGitCmd("bisect").Arg("log").ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+func (self *BisectCommands) Replay(logPath string) error {
+	cmdArgs := NewGitCmd("bisect").Arg("replay", logPath).ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
 // tells us whether we've found our problem commit(s). We return a string slice of
 // commit sha's if we're done, and that slice may have more that one item if
 // skipped commits are involved.
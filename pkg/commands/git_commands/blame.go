@@ -2,6 +2,11 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type BlameCommands struct {
@@ -31,3 +36,113 @@ func (self *BlameCommands) BlameLineRange(filename string, commit string, firstL
 
 	return self.cmd.New(cmdArgs.ToArgv()).RunWithOutput()
 }
+
+// Blame a whole file. If commit is empty, the file is blamed as it is in the
+// working tree, in which case uncommitted lines are attributed to a commit with
+// an all-zero SHA.
+func (self *BlameCommands) BlameFile(filename string, commit string) ([]*models.BlameLine, error) {
+	cmdArgs := NewGitCmd("blame").
+		Arg("--porcelain").
+		ArgIf(commit != "", commit).
+		Arg("--").
+		Arg(filename).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(output), nil
+}
+
+// The porcelain format consists of a header line per blamed line of the form
+// "<sha> <orig line> <final line> [<num lines in group>]", followed by the
+// commit's metadata the first time that commit appears, followed by the line
+// content prefixed with a tab.
+func parseBlamePorcelain(output string) []*models.BlameLine {
+	type commitInfo struct {
+		author           string
+		authorTimestamp  int64
+		summary          string
+		previousSha      string
+		previousFilename string
+		filename         string
+	}
+
+	commitInfos := map[string]*commitInfo{}
+	result := []*models.BlameLine{}
+
+	var currentSha string
+	var currentLineNumber int
+
+	for _, line := range utils.SplitLines(output) {
+		if strings.HasPrefix(line, "\t") {
+			info := commitInfos[currentSha]
+			if info == nil {
+				continue
+			}
+			result = append(result, &models.BlameLine{
+				Sha:              currentSha,
+				Author:           info.author,
+				AuthorTimestamp:  info.authorTimestamp,
+				Summary:          info.summary,
+				PreviousSha:      info.previousSha,
+				PreviousFilename: info.previousFilename,
+				Filename:         info.filename,
+				LineNumber:       currentLineNumber,
+				Content:          line[1:],
+			})
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+
+		if isCommitHash(key) {
+			fields := strings.Fields(value)
+			if len(fields) < 2 {
+				continue
+			}
+			currentSha = key
+			currentLineNumber, _ = strconv.Atoi(fields[1])
+			if _, ok := commitInfos[currentSha]; !ok {
+				commitInfos[currentSha] = &commitInfo{}
+			}
+			continue
+		}
+
+		info := commitInfos[currentSha]
+		if info == nil {
+			continue
+		}
+
+		switch key {
+		case "author":
+			info.author = value
+		case "author-time":
+			info.authorTimestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "summary":
+			info.summary = value
+		case "previous":
+			info.previousSha, info.previousFilename, _ = strings.Cut(value, " ")
+		case "filename":
+			info.filename = value
+		}
+	}
+
+	return result
+}
+
+// Full hashes are 40 characters long in SHA-1 repos and 64 in SHA-256 ones
+func isCommitHash(str string) bool {
+	if len(str) != 40 && len(str) != 64 {
+		return false
+	}
+
+	for _, ch := range str {
+		if !strings.ContainsRune("0123456789abcdef", ch) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+const blamePorcelainOutput = `5e050bfc5647a0902b373c18068272a3a212d8ed 1 1 1
+author John Smith
+author-mail <john@example.com>
+author-time 1700000000
+author-tz +0000
+committer John Smith
+committer-mail <john@example.com>
+committer-time 1700000000
+committer-tz +0000
+summary first
+boundary
+filename old.txt
+	one
+8d84753bea3e098580d018899669f87475a6e328 2 2 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1700001000
+author-tz +0000
+committer Jane Doe
+committer-mail <jane@example.com>
+committer-time 1700001000
+committer-tz +0000
+summary second
+previous 5e050bfc5647a0902b373c18068272a3a212d8ed old.txt
+filename file.txt
+	TWO
+8d84753bea3e098580d018899669f87475a6e328 3 3
+	three
+5e050bfc5647a0902b373c18068272a3a212d8ed 2 4 1
+filename old.txt
+	four
+`
+
+func TestBlameFile(t *testing.T) {
+	type scenario struct {
+		testName      string
+		commit        string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedLines []*models.BlameLine
+		expectedError string
+	}
+
+	first := func(lineNumber int, content string) *models.BlameLine {
+		return &models.BlameLine{
+			Sha:             "5e050bfc5647a0902b373c18068272a3a212d8ed",
+			Author:          "John Smith",
+			AuthorTimestamp: 1700000000,
+			Summary:         "first",
+			Filename:        "old.txt",
+			LineNumber:      lineNumber,
+			Content:         content,
+		}
+	}
+
+	second := func(lineNumber int, content string) *models.BlameLine {
+		return &models.BlameLine{
+			Sha:              "8d84753bea3e098580d018899669f87475a6e328",
+			Author:           "Jane Doe",
+			AuthorTimestamp:  1700001000,
+			Summary:          "second",
+			PreviousSha:      "5e050bfc5647a0902b373c18068272a3a212d8ed",
+			PreviousFilename: "old.txt",
+			Filename:         "file.txt",
+			LineNumber:       lineNumber,
+			Content:          content,
+		}
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "working tree",
+			commit:   "",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"blame", "--porcelain", "--", "file.txt"}, blamePorcelainOutput, nil),
+			expectedLines: []*models.BlameLine{
+				first(1, "one"),
+				second(2, "TWO"),
+				second(3, "three"),
+				first(4, "four"),
+			},
+		},
+		{
+			testName: "sha256 repo",
+			commit:   "",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"blame", "--porcelain", "--", "file.txt"},
+					"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824 1 1 1\nauthor John Smith\nauthor-time 1700000000\nsummary first\nfilename file.txt\n\tone\n", nil),
+			expectedLines: []*models.BlameLine{
+				{
+					Sha:             "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+					Author:          "John Smith",
+					AuthorTimestamp: 1700000000,
+					Summary:         "first",
+					Filename:        "file.txt",
+					LineNumber:      1,
+					Content:         "one",
+				},
+			},
+		},
+		{
+			testName: "at commit",
+			commit:   "abc123",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"blame", "--porcelain", "abc123", "--", "file.txt"}, "", nil),
+			expectedLines: []*models.BlameLine{},
+		},
+		{
+			testName: "error",
+			commit:   "abc123",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"blame", "--porcelain", "abc123", "--", "file.txt"}, "", errors.New("no such path")),
+			expectedLines: nil,
+			expectedError: "no such path",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := NewBlameCommands(buildGitCommon(commonDeps{runner: s.runner}))
+
+			lines, err := instance.BlameFile("file.txt", s.commit)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.EqualValues(t, s.expectedLines, lines)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -61,6 +61,15 @@ func TestBranchNewBranch(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestBranchNewBranchWithoutCheckout(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"branch", "test", "abc123"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.NewWithoutCheckout("test", "abc123"))
+	runner.CheckForMissingCalls()
+}
+
 func TestBranchDeleteBranch(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -2,10 +2,15 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
@@ -54,6 +59,49 @@ func (self *CommitCommands) AddCoAuthor(sha string, value string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Adds a trailer such as 'Signed-off-by: Name <email>' to the given message.
+// We leave it to git to work out where the trailer goes and whether the
+// message already has it.
+func (self *CommitCommands) AddTrailer(message string, trailer string, value string) (string, error) {
+	path := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), time.Now().Format("Jan _2 15.04.05.000000000")+".msg")
+	if err := self.os.CreateFileWithContent(path, message); err != nil {
+		return "", err
+	}
+
+	cmdArgs := NewGitCmd("interpret-trailers").
+		Arg("--trailer", fmt.Sprintf("%s: %s", trailer, value), path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(output, "\n"), nil
+}
+
+// Returns the message template that the commit.template config points to, or
+// an empty string if there isn't one. Comment lines are removed because git
+// doesn't strip them from messages passed with -m.
+func (self *CommitCommands) GetCommitTemplate() (string, error) {
+	path := self.config.GetCommitTemplatePath()
+	if path == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	commentChar := string(self.config.GetCoreCommentChar())
+	lines := lo.Reject(strings.Split(string(content), "\n"), func(line string, _ int) bool {
+		return strings.HasPrefix(line, commentChar)
+	})
+
+	return strings.TrimRightFunc(strings.Join(lines, "\n"), unicode.IsSpace), nil
+}
+
 // ResetToCommit reset to commit
 func (self *CommitCommands) ResetToCommit(sha string, strength string, envVars []string) error {
 	cmdArgs := NewGitCmd("reset").Arg("--"+strength, sha).ToArgv()
@@ -247,6 +295,7 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 		Arg("--stat").
 		Arg("--decorate").
 		Arg("-p").
+		Arg(self.notesArgs()...).
 		Arg(sha).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
 		ArgIf(filterPath != "", "--", filterPath).
@@ -256,6 +305,44 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// git show only includes notes from the default notes ref, so if further refs
+// are configured we need to ask for each of them explicitly (together with the
+// default one, which would otherwise be dropped)
+func (self *CommitCommands) notesArgs() []string {
+	if len(self.UserConfig.Git.NotesRefs) == 0 {
+		return nil
+	}
+
+	return lo.Map(notesRefs(self.UserConfig), func(notesRef string, _ int) string {
+		if notesRef == "" {
+			return "--notes"
+		}
+		return "--notes=" + notesRef
+	})
+}
+
+// Shows the changes made to a range of lines by the commit at position skip in
+// the history of that range (as listed by `git log -L<lineRange> <ref>`). We
+// can't simply pass the commit's sha because the line numbers refer to the
+// file as it is in ref, so git needs to follow the range from there.
+func (self *CommitCommands) ShowLineRangeCmdObj(ref string, lineRange string, skip int) oscommands.ICmdObj {
+	logOrder := self.UserConfig.Git.Log.Order
+
+	cmdArgs := NewGitCmd("log").
+		Arg(ref).
+		ArgIf(logOrder != "default", "--"+logOrder).
+		Arg("--color=" + self.UserConfig.Git.Paging.ColorArg).
+		Arg("--decorate").
+		Arg("-L" + lineRange).
+		Arg(fmt.Sprintf("--skip=%d", skip)).
+		Arg("--max-count=1").
+		Arg("--no-show-signature").
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 // Revert reverts the selected commit by sha
 func (self *CommitCommands) Revert(sha string) error {
 	cmdArgs := NewGitCmd("revert").Arg(sha).ToArgv()
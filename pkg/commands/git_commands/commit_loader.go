@@ -62,8 +62,10 @@ func NewCommitLoader(
 }
 
 type GetCommitsOptions struct {
-	Limit                bool
-	FilterPath           string
+	Limit      bool
+	FilterPath string
+	// further criteria for filtering the commits, on top of FilterPath
+	Filter               LogFilter
 	IncludeRebaseCommits bool
 	RefName              string // e.g. "HEAD" or "my_branch"
 	RefForPushedStatus   string // the ref to use for determining pushed/unpushed status
@@ -71,6 +73,11 @@ type GetCommitsOptions struct {
 	All bool
 	// If non-empty, show divergence from this ref (left-right log)
 	RefToShowDivergenceFrom string
+	// If non-empty, only show commits that touched this range of lines, given
+	// in the form "<start>,<end>:<path>" that `git log -L` expects. The line
+	// numbers refer to the file as it is in RefName. Takes precedence over
+	// FilterPath.
+	FilterLineRange string
 }
 
 // GetCommits obtains the commits of the current branch
@@ -78,7 +85,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	commits := []*models.Commit{}
 	var rebasingCommits []*models.Commit
 
-	if opts.IncludeRebaseCommits && opts.FilterPath == "" {
+	if opts.IncludeRebaseCommits && opts.FilterPath == "" && opts.Filter.IsEmpty() {
 		var err error
 		rebasingCommits, err = self.MergeRebasingCommits(commits)
 		if err != nil {
@@ -89,7 +96,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 
 	wg := sync.WaitGroup{}
 
-	wg.Add(2)
+	wg.Add(3)
 
 	var logErr error
 	go utils.Safe(func() {
@@ -113,6 +120,13 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		}
 	})
 
+	var shasWithNotes map[string]bool
+	go utils.Safe(func() {
+		defer wg.Done()
+
+		shasWithNotes = self.getShasWithNotes()
+	})
+
 	passedFirstPushedCommit := false
 	// I can get this before
 	firstPushedCommit, err := self.getFirstPushedCommit(opts.RefForPushedStatus)
@@ -128,6 +142,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	}
 
 	for _, commit := range commits {
+		commit.HasNotes = shasWithNotes[commit.Sha]
 		if commit.Sha == firstPushedCommit {
 			passedFirstPushedCommit = true
 		}
@@ -140,6 +155,18 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		}
 	}
 
+	if opts.Filter.UnsignedOnly {
+		signedShas, err := self.getSignedShas(lo.Map(commits, func(commit *models.Commit, _ int) string {
+			return commit.Sha
+		}))
+		if err != nil {
+			return nil, err
+		}
+		commits = lo.Filter(commits, func(commit *models.Commit, _ int) bool {
+			return !signedShas[commit.Sha]
+		})
+	}
+
 	if len(commits) == 0 {
 		return commits, nil
 	}
@@ -166,6 +193,141 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	return commits, nil
 }
 
+// Returns the set of commits that have a note attached, in either the default
+// notes ref or one of the configured ones
+func (self *CommitLoader) getShasWithNotes() map[string]bool {
+	result := map[string]bool{}
+	for _, notesRef := range notesRefs(self.UserConfig) {
+		cmdArgs := NewGitCmd("notes").
+			ArgIf(notesRef != "", "--ref="+notesRef).
+			Arg("list").
+			ToArgv()
+
+		output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+		if err != nil {
+			continue
+		}
+
+		// each line is of the form '<note object sha> <annotated commit sha>'
+		for _, line := range utils.SplitLines(output) {
+			fields := strings.Fields(line)
+			if len(fields) == 2 {
+				result[fields[1]] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// Returns the SHAs of those of the given commits that carry a signature,
+// without verifying it. This only looks at the commit headers, so unlike
+// GetSignatures it is cheap enough to run while loading the commits.
+func (self *CommitLoader) getSignedShas(shas []string) (map[string]bool, error) {
+	result := map[string]bool{}
+
+	// we pass the SHAs on the command line, so we don't want too many at once
+	for _, chunk := range lo.Chunk(shas, 100) {
+		cmdArgs := NewGitCmd("log").
+			Arg("--no-walk=unsorted", "--format=raw").
+			Arg(chunk...).
+			Arg("--").
+			ToArgv()
+
+		// the headers of each commit are printed unindented, starting with a
+		// 'commit <sha>' line; the message lines are indented
+		currentSha := ""
+		err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+			if sha, ok := strings.CutPrefix(line, "commit "); ok {
+				currentSha = sha
+			} else if strings.HasPrefix(line, "gpgsig") {
+				// either 'gpgsig' or 'gpgsig-sha256'
+				result[currentSha] = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Verifies the signatures of the given commits and stores the result in each
+// commit's Signature field.
+func (self *CommitLoader) SetSignatures(commits []*models.Commit) error {
+	signatures, err := self.GetSignatures(lo.Map(commits, func(commit *models.Commit, _ int) string {
+		return commit.Sha
+	}))
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range commits {
+		commit.Signature = signatures[commit.Sha]
+	}
+
+	return nil
+}
+
+// Verifies the signatures of the given commits, returning them by SHA. This is
+// slow (git calls out to gpg or ssh-keygen for every signed commit), which is
+// why it's not part of loading the commits.
+func (self *CommitLoader) GetSignatures(shas []string) (map[string]*models.CommitSignature, error) {
+	result := make(map[string]*models.CommitSignature, len(shas))
+
+	// we pass the SHAs on the command line, so we don't want too many at once
+	for _, chunk := range lo.Chunk(shas, 100) {
+		cmdArgs := NewGitCmd("log").
+			Arg("--no-walk=unsorted", signatureFormat).
+			Arg(chunk...).
+			Arg("--").
+			ToArgv()
+
+		err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+			sha, signature := parseSignatureLine(line)
+			if signature != nil {
+				result[sha] = signature
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Parses a line of git log output in the form of signatureFormat
+func parseSignatureLine(line string) (string, *models.CommitSignature) {
+	split := strings.SplitN(line, "\x00", 4)
+	if len(split) < 4 {
+		return "", nil
+	}
+
+	return split[0], &models.CommitSignature{
+		Status: parseSignatureStatus(split[1]),
+		Key:    split[2],
+		Signer: split[3],
+	}
+}
+
+// See the description of %G? in `git help log`
+func parseSignatureStatus(status string) models.SignatureStatus {
+	switch status {
+	case "G":
+		return models.SignatureStatusGood
+	case "B", "R":
+		return models.SignatureStatusBad
+	case "U", "X", "Y", "E":
+		return models.SignatureStatusUnknown
+	default:
+		return models.SignatureStatusNone
+	}
+}
+
 func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*models.Commit, error) {
 	// chances are we have as many commits as last time so we'll set the capacity to be the old length
 	result := make([]*models.Commit, 0, len(commits))
@@ -657,6 +819,12 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		refSpec += "..." + opts.RefToShowDivergenceFrom
 	}
 
+	// git doesn't allow combining -L with a pathspec
+	filterPath := opts.FilterPath
+	if opts.FilterLineRange != "" {
+		filterPath = ""
+	}
+
 	cmdArgs := NewGitCmd("log").
 		Arg(refSpec).
 		ArgIf(config.Order != "default", "--"+config.Order).
@@ -665,14 +833,18 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.Limit, "-300").
-		ArgIf(opts.FilterPath != "", "--follow").
+		ArgIf(filterPath != "", "--follow").
+		Arg(opts.Filter.args()...).
+		ArgIf(opts.FilterLineRange != "", "-L"+opts.FilterLineRange, "--no-patch").
 		Arg("--no-show-signature").
 		ArgIf(opts.RefToShowDivergenceFrom != "", "--left-right").
 		Arg("--").
-		ArgIf(opts.FilterPath != "", opts.FilterPath).
+		ArgIf(filterPath != "", filterPath).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
 const prettyFormat = `--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m`
+
+const signatureFormat = `--pretty=format:%H%x00%G?%x00%GK%x00%GS`
@@ -35,6 +35,7 @@ func TestGetCommits(t *testing.T) {
 		rebaseMode      enums.RebaseMode
 		opts            GetCommitsOptions
 		mainBranches    []string
+		notesRefs       []string
 	}
 
 	scenarios := []scenario{
@@ -45,6 +46,7 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
@@ -57,6 +59,7 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "refs/heads/mybranch", RefForPushedStatus: "refs/heads/mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "refs/heads/mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "refs/heads/mybranch", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
@@ -72,6 +75,7 @@ func TestGetCommits(t *testing.T) {
 				// here it's seeing which commits are yet to be pushed
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, commitsOutput, nil).
 				// here it's testing which of the configured main branches have an upstream
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).       // this one does
@@ -209,6 +213,7 @@ func TestGetCommits(t *testing.T) {
 				// here it's seeing which commits are yet to be pushed
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
 				// here it's testing which of the configured main branches exist; neither does
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "", errors.New("error")).
@@ -246,6 +251,7 @@ func TestGetCommits(t *testing.T) {
 				// here it's seeing which commits are yet to be pushed
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
 				// here it's testing which of the configured main branches exist
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).
@@ -282,6 +288,7 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
@@ -294,11 +301,111 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", FilterPath: "src"},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
 				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--follow", "--no-show-signature", "--", "src"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
 		},
+		{
+			testName:   "should set filter path and log filter",
+			logOrder:   "default",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			opts: GetCommitsOptions{
+				RefName:            "HEAD",
+				RefForPushedStatus: "mybranch",
+				FilterPath:         "src",
+				Filter: LogFilter{
+					Author:         "Jesse",
+					Message:        "fix",
+					Since:          "2 weeks ago",
+					Until:          "yesterday",
+					Content:        "foo.*bar",
+					ContentIsRegex: true,
+				},
+			},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--follow", "--author=Jesse", "--grep=fix", "--since=2 weeks ago", "--until=yesterday", "-Gfoo.*bar", "--no-show-signature", "--", "src"}, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:   "should set filter line range, ignoring filter path",
+			logOrder:   "default",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", FilterPath: "src", FilterLineRange: "10,20:src/main.go"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "-L10,20:src/main.go", "--no-patch", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:   "should mark commits that have notes in any of the notes refs",
+			logOrder:   "default",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
+			notesRefs:  []string{"refs/notes/review"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "45b983be36b73c0788dc9cbcb76cbb80fc7bb057 0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/review", "list"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:           "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:          "better typing for rebase mode",
+					Status:        models.StatusUnpushed,
+					Action:        models.ActionNone,
+					Tags:          []string{},
+					ExtraInfo:     "(HEAD -> better-tests)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826609,
+					HasNotes:      true,
+					Parents: []string{
+						"b21997d6b4cbdf84b149",
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName:   "should only keep unsigned commits",
+			logOrder:   "default",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", Limit: true, Filter: LogFilter{UnsignedOnly: true}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m", "--abbrev=40", "-300", "--no-show-signature", "--"}, strings.Join(strings.Split(commitsOutput, "\n")[:2], "\n"), nil).
+				ExpectGitArgs([]string{"log", "--no-walk=unsorted", "--format=raw", "0eea75e8c631fba6b58135697835d58ba4c18dbc", "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", "--"},
+					"commit 0eea75e8c631fba6b58135697835d58ba4c18dbc\ntree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nparent b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164\nauthor Jesse Duffield <jessedduffield@gmail.com> 1640826609 +1100\ncommitter Jesse Duffield <jessedduffield@gmail.com> 1640826609 +1100\ngpgsig -----BEGIN PGP SIGNATURE-----\n \n iQEzBAABCAAdFiEE\n -----END PGP SIGNATURE-----\n\n    better typing for rebase mode\n\ncommit b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164\ntree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nparent e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c\nauthor Jesse Duffield <jessedduffield@gmail.com> 1640824515 +1100\ncommitter Jesse Duffield <jessedduffield@gmail.com> 1640824515 +1100\n\n    fix logging\n", nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:           "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:          "fix logging",
+					Status:        models.StatusPushed,
+					Action:        models.ActionNone,
+					Tags:          []string{},
+					ExtraInfo:     "(origin/better-tests)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640824515,
+					Parents: []string{
+						"e94e8fc5b6fab4cb755f",
+					},
+				},
+			},
+			expectedError: nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -306,6 +413,7 @@ func TestGetCommits(t *testing.T) {
 		t.Run(scenario.testName, func(t *testing.T) {
 			common := utils.NewDummyCommon()
 			common.UserConfig.Git.Log.Order = scenario.logOrder
+			common.UserConfig.Git.NotesRefs = scenario.notesRefs
 
 			builder := &CommitLoader{
 				Common:        common,
@@ -554,3 +662,24 @@ func TestCommitLoader_setCommitMergedStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSignatureStatus(t *testing.T) {
+	scenarios := []struct {
+		status   string
+		expected models.SignatureStatus
+	}{
+		{"G", models.SignatureStatusGood},
+		{"U", models.SignatureStatusUnknown},
+		{"X", models.SignatureStatusUnknown},
+		{"Y", models.SignatureStatusUnknown},
+		{"E", models.SignatureStatusUnknown},
+		{"B", models.SignatureStatusBad},
+		{"R", models.SignatureStatusBad},
+		{"N", models.SignatureStatusNone},
+		{"", models.SignatureStatusNone},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, parseSignatureStatus(s.status), s.status)
+	}
+}
@@ -1,8 +1,12 @@
 package git_commands
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -187,6 +191,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 		contextSize      int
 		ignoreWhitespace bool
 		extDiffCmd       string
+		notesRefs        []string
 		expected         []string
 	}
 
@@ -231,6 +236,15 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:       "difft --color=always",
 			expected:         []string{"-C", "/path/to/worktree", "-c", "diff.external=difft --color=always", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890"},
 		},
+		{
+			testName:         "Show diff with notes refs configured",
+			filterPath:       "",
+			contextSize:      3,
+			ignoreWhitespace: false,
+			extDiffCmd:       "",
+			notesRefs:        []string{"refs/notes/review"},
+			expected:         []string{"-C", "/path/to/worktree", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "--notes", "--notes=refs/notes/review", "1234567890"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -238,6 +252,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 		t.Run(s.testName, func(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.Paging.ExternalDiffCommand = s.extDiffCmd
+			userConfig.Git.NotesRefs = s.notesRefs
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
@@ -254,6 +269,47 @@ func TestCommitShowCmdObj(t *testing.T) {
 	}
 }
 
+func TestCommitShowLineRangeCmdObj(t *testing.T) {
+	type scenario struct {
+		testName string
+		logOrder string
+		skip     int
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Default log order",
+			logOrder: "default",
+			skip:     0,
+			expected: []string{"-C", "/path/to/worktree", "log", "HEAD", "--color=always", "--decorate", "-L10,20:file.go", "--skip=0", "--max-count=1", "--no-show-signature"},
+		},
+		{
+			testName: "Topological log order",
+			logOrder: "topo-order",
+			skip:     3,
+			expected: []string{"-C", "/path/to/worktree", "log", "HEAD", "--topo-order", "--color=always", "--decorate", "-L10,20:file.go", "--skip=3", "--max-count=1", "--no-show-signature"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Log.Order = s.logOrder
+
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
+			repoPaths := RepoPaths{
+				worktreePath: "/path/to/worktree",
+			}
+			instance := buildCommitCommands(commonDeps{userConfig: userConfig, runner: runner, repoPaths: &repoPaths})
+
+			assert.NoError(t, instance.ShowLineRangeCmdObj("HEAD", "10,20:file.go", s.skip).Run())
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestGetCommitMsg(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -333,3 +389,97 @@ func TestGetCommitMessageFromHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitGetCommitTemplate(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "template")
+	assert.NoError(t, os.WriteFile(templatePath, []byte("\n\n# Explain why\nRefs: \n; not a comment\n\n"), 0o644))
+
+	type scenario struct {
+		testName        string
+		gitConfig       map[string]string
+		expectedMessage string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:        "no template",
+			gitConfig:       map[string]string{},
+			expectedMessage: "",
+		},
+		{
+			testName:        "comment lines are removed",
+			gitConfig:       map[string]string{"--path --get commit.template": templatePath + "\n"},
+			expectedMessage: "\n\nRefs: \n; not a comment",
+		},
+		{
+			testName: "custom comment char",
+			gitConfig: map[string]string{
+				"--path --get commit.template": templatePath + "\n",
+				"core.commentChar":             ";",
+			},
+			expectedMessage: "\n\n# Explain why\nRefs:",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)})
+
+			message, err := instance.GetCommitTemplate()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedMessage, message)
+		})
+	}
+}
+
+func TestCommitAddTrailer(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedMessage string
+		expectedError   string
+	}
+
+	// the message is passed in a temp file whose name we don't know up front
+	interpretTrailers := func(output string, err error) *oscommands.FakeCmdObjRunner {
+		return oscommands.NewFakeRunner(t).
+			ExpectFunc("interpret-trailers", func(cmdObj oscommands.ICmdObj) bool {
+				args := cmdObj.Args()
+				if len(args) != 5 || args[1] != "interpret-trailers" || args[2] != "--trailer" || args[3] != "Signed-off-by: Jane <jane@example.com>" {
+					return false
+				}
+				content, readErr := os.ReadFile(args[4])
+				return readErr == nil && string(content) == "subject\n\nbody"
+			}, output, err)
+	}
+
+	scenarios := []scenario{
+		{
+			testName:        "trailer is added",
+			runner:          interpretTrailers("subject\n\nbody\n\nSigned-off-by: Jane <jane@example.com>\n", nil),
+			expectedMessage: "subject\n\nbody\n\nSigned-off-by: Jane <jane@example.com>",
+		},
+		{
+			testName:      "git fails",
+			runner:        interpretTrailers("", errors.New("error")),
+			expectedError: "error",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+
+			message, err := instance.AddTrailer("subject\n\nbody", "Signed-off-by", "Jane <jane@example.com>")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedMessage, message)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -108,6 +109,29 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 	return '#'
 }
 
+// Returns the path of the commit message template, with a leading ~ expanded
+func (self *ConfigCommands) GetCommitTemplatePath() string {
+	return strings.TrimSpace(self.gitConfig.GetGeneral("--path --get commit.template"))
+}
+
+// Returns the configured identity in the form 'Name <email>', and false if
+// either part isn't configured
+func (self *ConfigCommands) GetUserIdentity() (string, bool) {
+	name := self.gitConfig.Get("user.name")
+	email := self.gitConfig.Get("user.email")
+	if name == "" || email == "" {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s <%s>", name, email), true
+}
+
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
+
+// GetRerereEnabled returns the value of rerere.enabled, and whether it is set at
+// all; if it isn't, git decides based on whether the rr-cache directory exists
+func (self *ConfigCommands) GetRerereEnabled() (bool, bool) {
+	return self.gitConfig.GetBool("rerere.enabled"), self.gitConfig.Get("rerere.enabled") != ""
+}
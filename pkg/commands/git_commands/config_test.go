@@ -0,0 +1,47 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigGetUserIdentity(t *testing.T) {
+	type scenario struct {
+		testName         string
+		gitConfig        map[string]string
+		expectedIdentity string
+		expectedOk       bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "name and email configured",
+			gitConfig:        map[string]string{"user.name": "Jane", "user.email": "jane@example.com"},
+			expectedIdentity: "Jane <jane@example.com>",
+			expectedOk:       true,
+		},
+		{
+			testName:   "no email",
+			gitConfig:  map[string]string{"user.name": "Jane"},
+			expectedOk: false,
+		},
+		{
+			testName:   "nothing configured",
+			gitConfig:  map[string]string{},
+			expectedOk: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}).config
+
+			identity, ok := instance.GetUserIdentity()
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expectedIdentity, identity)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package git_commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type ConflictPreviewCommands struct {
+	*GitCommon
+}
+
+func NewConflictPreviewCommands(gitCommon *GitCommon) *ConflictPreviewCommands {
+	return &ConflictPreviewCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// The outcome of a merge or rebase that was simulated without touching the
+// working tree or any refs
+type ConflictPreview struct {
+	// The tree that the merge or rebase would result in. If there are
+	// conflicts, the conflicted files contain conflict markers, and for a
+	// rebase it's the tree of the commit that would stop with conflicts.
+	Tree            string
+	ConflictedFiles []string
+	// Only set for a rebase that would stop with conflicts
+	ConflictingCommitSha     string
+	ConflictingCommitSubject string
+}
+
+func (self *ConflictPreview) HasConflicts() bool {
+	return len(self.ConflictedFiles) > 0
+}
+
+func (self *ConflictPreviewCommands) IsSupported() bool {
+	return self.version.IsAtLeast(2, 38, 0)
+}
+
+// Simulates merging ref into HEAD
+func (self *ConflictPreviewCommands) PreviewMerge(ref string) (*ConflictPreview, error) {
+	if !self.IsSupported() {
+		return nil, errors.New(self.Tr.ConflictPreviewRequiresNewerGit)
+	}
+
+	tree, conflictedFiles, err := self.mergeTree("HEAD", ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConflictPreview{Tree: tree, ConflictedFiles: conflictedFiles}, nil
+}
+
+// Simulates rebasing HEAD onto ref by applying its commits one by one, the
+// same way that a rebase would. If baseCommit is given, only the commits
+// after it are rebased, as with a marked base commit. Each commit costs a
+// commit-tree and a merge-tree, so if there are more than maxCommits commits to
+// rebase we don't simulate anything and return nil; 0 means no limit.
+func (self *ConflictPreviewCommands) PreviewRebase(onto string, baseCommit string, maxCommits int) (*ConflictPreview, error) {
+	if !self.IsSupported() {
+		return nil, errors.New(self.Tr.ConflictPreviewRequiresNewerGit)
+	}
+
+	commits, err := self.commitsToRebase(onto, baseCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxCommits > 0 && len(commits) > maxCommits {
+		return nil, nil
+	}
+
+	tree, err := self.revParse(onto + "^{tree}")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, commit := range commits {
+		// merge-tree in git versions before 2.40 can't be told which merge base
+		// to use, so we give it a commit with the tree we have so far whose
+		// parent is the parent of the commit we're applying. Their merge base
+		// is then that parent, just like when cherry-picking.
+		ours, err := self.commitTree(tree, commit.parentSha)
+		if err != nil {
+			return nil, err
+		}
+
+		var conflictedFiles []string
+		tree, conflictedFiles, err = self.mergeTree(ours, commit.sha)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(conflictedFiles) > 0 {
+			return &ConflictPreview{
+				Tree:                     tree,
+				ConflictedFiles:          conflictedFiles,
+				ConflictingCommitSha:     commit.sha,
+				ConflictingCommitSubject: commit.subject,
+			}, nil
+		}
+	}
+
+	return &ConflictPreview{Tree: tree}, nil
+}
+
+// Shows what the merge or rebase would change in the working tree
+func (self *ConflictPreviewCommands) ShowPreviewCmdObj(preview *ConflictPreview) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--submodule", "--no-ext-diff").
+		Arg(fmt.Sprintf("--unified=%d", self.AppState.DiffContextSize)).
+		Arg(fmt.Sprintf("--color=%s", self.UserConfig.Git.Paging.ColorArg)).
+		Arg("HEAD", preview.Tree).
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
+type commitToRebase struct {
+	sha       string
+	parentSha string
+	subject   string
+}
+
+// Like a rebase, leaves out merge commits and commits whose changes are
+// already in onto
+func (self *ConflictPreviewCommands) commitsToRebase(onto string, baseCommit string) ([]commitToRebase, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--reverse", "--no-merges", "--format=%H%x00%P%x00%s").
+		ArgIf(baseCommit == "", "--right-only", "--cherry-pick").
+		ArgIfElse(baseCommit != "", baseCommit+"..HEAD", onto+"...HEAD").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (commitToRebase, bool) {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) < 3 || fields[1] == "" {
+			return commitToRebase{}, false
+		}
+
+		return commitToRebase{sha: fields[0], parentSha: fields[1], subject: fields[2]}, true
+	}), nil
+}
+
+// Merges the two commits without touching the working tree, returning the
+// resulting tree and the files that have conflicts
+func (self *ConflictPreviewCommands) mergeTree(ours string, theirs string) (string, []string, error) {
+	cmdArgs := NewGitCmd("merge-tree").
+		Arg("--write-tree", "--name-only", "--no-messages").
+		Arg(ours, theirs).
+		ToArgv()
+
+	// merge-tree exits with status 1 if there are conflicts, in which case
+	// the tree and the conflicted files are still printed
+	stdout, _, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	lines := utils.SplitLines(stdout)
+	if len(lines) == 0 {
+		if err == nil {
+			err = errors.New("merge-tree did not return a tree")
+		}
+		return "", nil, err
+	}
+
+	return lines[0], lo.Uniq(lines[1:]), nil
+}
+
+func (self *ConflictPreviewCommands) commitTree(tree string, parent string) (string, error) {
+	cmdArgs := NewGitCmd("commit-tree").
+		Arg(tree, "-p", parent, "-m", "conflict preview").
+		ToArgv()
+
+	// The commit is thrown away, so it doesn't matter who made it, but git
+	// refuses to create it if no identity is configured
+	output, err := self.cmd.New(cmdArgs).
+		AddEnvVars(
+			"GIT_AUTHOR_NAME=lazygit", "GIT_AUTHOR_EMAIL=lazygit@localhost",
+			"GIT_COMMITTER_NAME=lazygit", "GIT_COMMITTER_EMAIL=lazygit@localhost",
+		).
+		DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+func (self *ConflictPreviewCommands) revParse(ref string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").Arg(ref).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
@@ -0,0 +1,127 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictPreviewPreviewMerge(t *testing.T) {
+	type scenario struct {
+		testName        string
+		gitVersion      *GitVersion
+		runner          *oscommands.FakeCmdObjRunner
+		expectedPreview *ConflictPreview
+		expectedError   string
+	}
+
+	mergeTreeArgs := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "HEAD", "feature"}
+
+	scenarios := []scenario{
+		{
+			testName:        "clean merge",
+			gitVersion:      &GitVersion{2, 38, 0, ""},
+			runner:          oscommands.NewFakeRunner(t).ExpectGitArgs(mergeTreeArgs, "1234abcd\n", nil),
+			expectedPreview: &ConflictPreview{Tree: "1234abcd", ConflictedFiles: []string{}},
+		},
+		{
+			testName:   "conflicts",
+			gitVersion: &GitVersion{2, 38, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, "1234abcd\nfile1\ndir/file 2\nfile1\n", errors.New("exit status 1")),
+			expectedPreview: &ConflictPreview{Tree: "1234abcd", ConflictedFiles: []string{"file1", "dir/file 2"}},
+		},
+		{
+			testName:      "merge-tree fails",
+			gitVersion:    &GitVersion{2, 38, 0, ""},
+			runner:        oscommands.NewFakeRunner(t).ExpectGitArgs(mergeTreeArgs, "", errors.New("not something we can merge")),
+			expectedError: "not something we can merge",
+		},
+		{
+			testName:      "git too old",
+			gitVersion:    &GitVersion{2, 37, 0, ""},
+			runner:        oscommands.NewFakeRunner(t),
+			expectedError: "Previewing conflicts requires git 2.38 or later.",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildConflictPreviewCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+
+			preview, err := instance.PreviewMerge("feature")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expectedPreview, preview)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestConflictPreviewPreviewRebase(t *testing.T) {
+	type scenario struct {
+		testName        string
+		baseCommit      string
+		maxCommits      int
+		runner          *oscommands.FakeCmdObjRunner
+		expectedPreview *ConflictPreview
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "clean rebase",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "--reverse", "--no-merges", "--format=%H%x00%P%x00%s", "--right-only", "--cherry-pick", "master...HEAD"},
+					"sha1\x00base\x00first\nsha2\x00sha1\x00second\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "master^{tree}"}, "tree0\n", nil).
+				ExpectGitArgs([]string{"commit-tree", "tree0", "-p", "base", "-m", "conflict preview"}, "ours1\n", nil).
+				ExpectGitArgs([]string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "ours1", "sha1"}, "tree1\n", nil).
+				ExpectGitArgs([]string{"commit-tree", "tree1", "-p", "sha1", "-m", "conflict preview"}, "ours2\n", nil).
+				ExpectGitArgs([]string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "ours2", "sha2"}, "tree2\n", nil),
+			expectedPreview: &ConflictPreview{Tree: "tree2"},
+		},
+		{
+			testName:   "stops at the first conflicting commit",
+			baseCommit: "base",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "--reverse", "--no-merges", "--format=%H%x00%P%x00%s", "base..HEAD"},
+					"sha1\x00base\x00first\nsha2\x00sha1\x00second\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "master^{tree}"}, "tree0\n", nil).
+				ExpectGitArgs([]string{"commit-tree", "tree0", "-p", "base", "-m", "conflict preview"}, "ours1\n", nil).
+				ExpectGitArgs([]string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "ours1", "sha1"},
+					"tree1\nfile\n", errors.New("exit status 1")),
+			expectedPreview: &ConflictPreview{
+				Tree:                     "tree1",
+				ConflictedFiles:          []string{"file"},
+				ConflictingCommitSha:     "sha1",
+				ConflictingCommitSubject: "first",
+			},
+		},
+		{
+			testName:   "too many commits",
+			maxCommits: 1,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "--reverse", "--no-merges", "--format=%H%x00%P%x00%s", "--right-only", "--cherry-pick", "master...HEAD"},
+					"sha1\x00base\x00first\nsha2\x00sha1\x00second\n", nil),
+			expectedPreview: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildConflictPreviewCommands(commonDeps{runner: s.runner, gitVersion: &GitVersion{2, 39, 0, ""}})
+
+			preview, err := instance.PreviewRebase("master", s.baseCommit, s.maxCommits)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPreview, preview)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -126,7 +126,7 @@ func buildWorkingTreeCommands(deps commonDeps) *WorkingTreeCommands {
 	return NewWorkingTreeCommands(gitCommon, submoduleCommands, fileLoader)
 }
 
-func buildPatchCommands(deps commonDeps) *PatchCommands { //nolint:golint,unused
+func buildPatchCommands(deps commonDeps) *PatchCommands {
 	gitCommon := buildGitCommon(deps)
 	rebaseCommands := buildRebaseCommands(deps)
 	commitCommands := buildCommitCommands(deps)
@@ -185,3 +185,39 @@ func buildFlowCommands(deps commonDeps) *FlowCommands {
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildLfsCommands(deps commonDeps) *LfsCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewLfsCommands(gitCommon)
+}
+
+func buildSparseCheckoutCommands(deps commonDeps) *SparseCheckoutCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewSparseCheckoutCommands(gitCommon)
+}
+
+func buildRangeDiffCommands(deps commonDeps) *RangeDiffCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRangeDiffCommands(gitCommon)
+}
+
+func buildMailboxCommands(deps commonDeps) *MailboxCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewMailboxCommands(gitCommon)
+}
+
+func buildConflictPreviewCommands(deps commonDeps) *ConflictPreviewCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewConflictPreviewCommands(gitCommon)
+}
+
+func buildRerereCommands(deps commonDeps) *RerereCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRerereCommands(gitCommon)
+}
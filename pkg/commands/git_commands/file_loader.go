@@ -7,6 +7,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -18,6 +19,8 @@ type FileLoader struct {
 	cmd         oscommands.ICmdObjBuilder
 	config      FileLoaderConfig
 	getFileType func(string) string
+	lfs         *LfsCommands
+	rerere      *RerereCommands
 }
 
 func NewFileLoader(gitCommon *GitCommon, cmd oscommands.ICmdObjBuilder, config FileLoaderConfig) *FileLoader {
@@ -26,6 +29,8 @@ func NewFileLoader(gitCommon *GitCommon, cmd oscommands.ICmdObjBuilder, config F
 		cmd:         cmd,
 		getFileType: oscommands.FileType,
 		config:      config,
+		lfs:         NewLfsCommands(gitCommon),
+		rerere:      NewRerereCommands(gitCommon),
 	}
 }
 
@@ -84,6 +89,16 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	lfsPaths := self.lfs.TrackedPaths(lo.FilterMap(files, func(file *models.File, _ int) (string, bool) {
+		return file.Name, !file.IsWorktree
+	}))
+	for _, file := range files {
+		file.IsLfs = lfsPaths[file.Name]
+	}
+
+	self.markWholeFileConflicts(files)
+	self.markFilesResolvedByRerere(files)
+
 	return files
 }
 
@@ -142,3 +157,67 @@ func (c *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 
 	return response, nil
 }
+
+// Git only writes conflict markers into text files, so binary files and
+// submodules that both sides changed have nothing to resolve inline. Instead,
+// one of the two versions has to be picked as a whole.
+func (self *FileLoader) markWholeFileConflicts(files []*models.File) {
+	if !lo.SomeBy(files, func(file *models.File) bool { return file.HasInlineMergeConflicts }) {
+		return
+	}
+
+	cmdArgs := NewGitCmd("ls-files").Arg("--unmerged", "--eol", "-z").ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	binaryPaths := map[string]bool{}
+	submodulePaths := map[string]bool{}
+	// each entry looks like "<mode> <object> <stage>\t<eol info>\t<path>",
+	// with one entry per stage of the path
+	for _, entry := range strings.Split(output, "\x00") {
+		fields := strings.SplitN(entry, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		mode, _, _ := strings.Cut(fields[0], " ")
+		if mode == "160000" {
+			submodulePaths[fields[2]] = true
+		} else if strings.HasPrefix(fields[1], "i/-text") {
+			binaryPaths[fields[2]] = true
+		}
+	}
+
+	for _, file := range files {
+		if binaryPaths[file.Name] || submodulePaths[file.Name] {
+			file.HasInlineMergeConflicts = false
+		}
+		file.HasBinaryConflict = binaryPaths[file.Name] && !submodulePaths[file.Name]
+	}
+}
+
+// When rerere resolves a conflict from a recorded resolution, the file stays
+// unmerged but has no conflict markers left, so without this it would look like
+// an ordinary modified file
+func (self *FileLoader) markFilesResolvedByRerere(files []*models.File) {
+	if !lo.SomeBy(files, func(file *models.File) bool { return file.HasInlineMergeConflicts }) {
+		return
+	}
+
+	if !self.rerere.IsEnabled() {
+		return
+	}
+
+	remainingPaths, err := self.rerere.GetRemainingPaths()
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	for _, file := range files {
+		file.IsResolvedByRerere = file.HasInlineMergeConflicts && !lo.Contains(remainingPaths, file.Name)
+	}
+}
@@ -28,6 +28,12 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
 					"MM file1.txt\x00A  file3.txt\x00AM file2.txt\x00?? file4.txt\x00UU file5.txt",
 					nil,
+				).
+				ExpectGitArgs([]string{"ls-files", "--unmerged", "--eol", "-z"},
+					"100644 aaa 1\ti/lf    w/lf    attr/                 \tfile5.txt\x00"+
+						"100644 bbb 2\ti/lf    w/lf    attr/                 \tfile5.txt\x00"+
+						"100644 ccc 3\ti/lf    w/lf    attr/                 \tfile5.txt\x00",
+					nil,
 				),
 			[]*models.File{
 				{
@@ -147,6 +153,63 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Binary and submodule conflicts",
+			oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+					"UU image.png\x00AA sub\x00DU deleted.txt",
+					nil,
+				).
+				ExpectGitArgs([]string{"ls-files", "--unmerged", "--eol", "-z"},
+					"100644 aaa 1\ti/-text w/-text attr/                 \timage.png\x00"+
+						"100644 bbb 2\ti/-text w/-text attr/                 \timage.png\x00"+
+						"100644 ccc 3\ti/-text w/-text attr/                 \timage.png\x00"+
+						"160000 ddd 2\ti/      w/      attr/                 \tsub\x00"+
+						"160000 eee 3\ti/      w/      attr/                 \tsub\x00"+
+						"100644 fff 1\ti/lf    w/      attr/                 \tdeleted.txt\x00"+
+						"100644 ggg 3\ti/lf    w/lf    attr/                 \tdeleted.txt\x00",
+					nil,
+				),
+			[]*models.File{
+				{
+					Name:                    "image.png",
+					HasStagedChanges:        false,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   false,
+					Deleted:                 false,
+					HasMergeConflicts:       true,
+					HasInlineMergeConflicts: false,
+					HasBinaryConflict:       true,
+					DisplayString:           "UU image.png",
+					ShortStatus:             "UU",
+				},
+				{
+					Name:                    "sub",
+					HasStagedChanges:        true,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   true,
+					Deleted:                 false,
+					HasMergeConflicts:       true,
+					HasInlineMergeConflicts: false,
+					DisplayString:           "AA sub",
+					ShortStatus:             "AA",
+				},
+				{
+					Name:                    "deleted.txt",
+					HasStagedChanges:        true,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   false,
+					Deleted:                 true,
+					HasMergeConflicts:       true,
+					HasInlineMergeConflicts: false,
+					DisplayString:           "DU deleted.txt",
+					ShortStatus:             "DU",
+				},
+			},
+		},
 		{
 			"File with arrow in name",
 			oscommands.NewFakeRunner(t).
@@ -176,11 +239,14 @@ func TestFileGetStatusFiles(t *testing.T) {
 		t.Run(s.testName, func(t *testing.T) {
 			cmd := oscommands.NewDummyCmdObjBuilder(s.runner)
 
+			gitCommon := buildGitCommon(commonDeps{})
 			loader := &FileLoader{
-				GitCommon:   buildGitCommon(commonDeps{}),
+				GitCommon:   gitCommon,
 				cmd:         cmd,
 				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
 				getFileType: func(string) string { return "file" },
+				lfs:         NewLfsCommands(gitCommon),
+				rerere:      NewRerereCommands(gitCommon),
 			}
 
 			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(GetStatusFileOptions{}))
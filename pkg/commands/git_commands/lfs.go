@@ -0,0 +1,238 @@
+package git_commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// Pointer files are guaranteed to be smaller than this, so anything bigger is
+// the actual content of an LFS object.
+const maxLfsPointerSize = 1024
+
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// What git stores in place of the content of an LFS-tracked file
+type LfsPointer struct {
+	Oid  string // e.g. 'sha256:4d7a2146...'
+	Size int64
+}
+
+// Returns true if the top-level .gitattributes routes any paths through the LFS
+// filter. We use this as a cheap check before asking git about individual files,
+// so that repos which don't use LFS pay nothing for it.
+func (self *LfsCommands) RepoUsesLfs() bool {
+	content, err := afero.ReadFile(self.Fs, filepath.Join(self.repoPaths.WorktreePath(), ".gitattributes"))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(content), "filter=lfs")
+}
+
+// Returns the subset of the given paths that are tracked by LFS, according to
+// .gitattributes. We only ask git about the given paths rather than listing all
+// LFS files in the repo, because this runs on every refresh of the files view.
+func (self *LfsCommands) TrackedPaths(paths []string) map[string]bool {
+	if len(paths) == 0 || !self.RepoUsesLfs() {
+		return map[string]bool{}
+	}
+
+	return self.pathsWithLfsFilter(paths)
+}
+
+// Returns true if .gitattributes assigns the lfs filter to the path
+func (self *LfsCommands) HasLfsFilter(path string) bool {
+	if !self.RepoUsesLfs() {
+		return false
+	}
+
+	return self.pathsWithLfsFilter([]string{path})[path]
+}
+
+func (self *LfsCommands) pathsWithLfsFilter(paths []string) map[string]bool {
+	result := map[string]bool{}
+	if len(paths) == 0 {
+		return result
+	}
+
+	cmdArgs := NewGitCmd("check-attr").
+		Arg("-z", "filter", "--").
+		Arg(paths...).
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Error(err)
+		return result
+	}
+
+	// output is a sequence of '<path>\0<attribute>\0<value>\0' triples
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result[fields[i]] = true
+		}
+	}
+
+	return result
+}
+
+// Returns the LFS pointer stored for the path at the given ref, or nil if the
+// path doesn't exist there or isn't stored in LFS. An empty ref means the index.
+func (self *LfsCommands) PointerAt(ref string, path string) *LfsPointer {
+	object := ref + ":" + path
+
+	// Check the size first so that we don't read the whole content of a large
+	// file just to find out that it isn't a pointer
+	sizeArgs := NewGitCmd("cat-file").Arg("-s", object).ToArgv()
+	sizeOutput, err := self.cmd.New(sizeArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(sizeOutput), 10, 64)
+	if err != nil || size >= maxLfsPointerSize {
+		return nil
+	}
+
+	cmdArgs := NewGitCmd("cat-file").Arg("-p", object).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	return parseLfsPointer(output)
+}
+
+// Returns the pointer for the file in the working tree. Usually the working tree
+// has the real content of the object (in which case we only know its size), but
+// if git-lfs isn't installed or the object hasn't been pulled it will contain
+// the pointer itself. Returns nil if the file doesn't exist.
+func (self *LfsCommands) WorkingTreePointer(path string) *LfsPointer {
+	absPath := filepath.Join(self.repoPaths.WorktreePath(), path)
+	info, err := self.Fs.Stat(absPath)
+	if err != nil {
+		return nil
+	}
+
+	if info.Size() < maxLfsPointerSize {
+		content, err := afero.ReadFile(self.Fs, absPath)
+		if err == nil {
+			if pointer := parseLfsPointer(string(content)); pointer != nil {
+				return pointer
+			}
+		}
+	}
+
+	return &LfsPointer{Size: info.Size()}
+}
+
+func parseLfsPointer(content string) *LfsPointer {
+	if !strings.HasPrefix(content, "version https://git-lfs.github.com/spec/") {
+		return nil
+	}
+
+	pointer := &LfsPointer{}
+	for _, line := range utils.SplitLines(content) {
+		key, value, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "oid":
+			pointer.Oid = value
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			pointer.Size = size
+		}
+	}
+
+	return pointer
+}
+
+// Returns the staged files, among the given ones, whose size in the working
+// tree exceeds the threshold but which aren't tracked by LFS
+func (self *LfsCommands) LargeNonLfsFiles(files []*models.File, threshold int64) []*models.File {
+	return lo.Filter(files, func(file *models.File, _ int) bool {
+		if !file.HasStagedChanges || file.Deleted || file.IsLfs {
+			return false
+		}
+
+		info, err := self.Fs.Stat(filepath.Join(self.repoPaths.WorktreePath(), file.Name))
+		if err != nil || info.IsDir() {
+			return false
+		}
+
+		return info.Size() > threshold
+	})
+}
+
+func (self *LfsCommands) Lock(path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("lock", "--", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *LfsCommands) Unlock(path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock", "--", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Unlocks by lock ID rather than path, so that we can release locks on files
+// which no longer exist locally. Force is needed for locks owned by someone else.
+func (self *LfsCommands) UnlockByID(id string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock", "--id="+id).
+		ArgIf(force, "--force").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+type lfsLockJson struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+}
+
+func (self *LfsCommands) GetLocks() ([]*models.LfsLock, error) {
+	cmdArgs := NewGitCmd("lfs").Arg("locks", "--json").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	var locks []lfsLockJson
+	if err := json.Unmarshal([]byte(output), &locks); err != nil {
+		return nil, err
+	}
+
+	return lo.Map(locks, func(lock lfsLockJson, _ int) *models.LfsLock {
+		return &models.LfsLock{
+			ID:    lock.ID,
+			Path:  lock.Path,
+			Owner: lock.Owner.Name,
+		}
+	}), nil
+}
@@ -0,0 +1,209 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+const lfsAttributes = "*.psd filter=lfs diff=lfs merge=lfs -text\n"
+
+func TestLfsTrackedPaths(t *testing.T) {
+	type scenario struct {
+		testName       string
+		gitattributes  string
+		paths          []string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedResult map[string]bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "repo without .gitattributes",
+			gitattributes:  "",
+			paths:          []string{"image.psd"},
+			runner:         oscommands.NewFakeRunner(t),
+			expectedResult: map[string]bool{},
+		},
+		{
+			testName:       "repo with .gitattributes that doesn't use lfs",
+			gitattributes:  "*.go diff=golang\n",
+			paths:          []string{"image.psd"},
+			runner:         oscommands.NewFakeRunner(t),
+			expectedResult: map[string]bool{},
+		},
+		{
+			testName:      "lfs and non-lfs files",
+			gitattributes: lfsAttributes,
+			paths:         []string{"image.psd", "main.go"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-attr", "-z", "filter", "--", "image.psd", "main.go"},
+					"image.psd\x00filter\x00lfs\x00main.go\x00filter\x00unspecified\x00", nil),
+			expectedResult: map[string]bool{"image.psd": true},
+		},
+		{
+			testName:      "check-attr fails",
+			gitattributes: lfsAttributes,
+			paths:         []string{"image.psd"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-attr", "-z", "filter", "--", "image.psd"},
+					"", errors.New("error")),
+			expectedResult: map[string]bool{},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.gitattributes != "" {
+				assert.NoError(t, afero.WriteFile(fs, "/repo/.gitattributes", []byte(s.gitattributes), 0o644))
+			}
+			instance := buildLfsCommands(commonDeps{runner: s.runner, fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+			assert.Equal(t, s.expectedResult, instance.TrackedPaths(s.paths))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestLfsPointerAt(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		ref      string
+		expected *LfsPointer
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "pointer in index",
+			ref:      "",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":image.psd"}, "130\n", nil).
+				ExpectGitArgs([]string{"cat-file", "-p", ":image.psd"},
+					"version https://git-lfs.github.com/spec/v1\noid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393\nsize 12345\n", nil),
+			expected: &LfsPointer{Oid: "sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393", Size: 12345},
+		},
+		{
+			testName: "small file that isn't a pointer",
+			ref:      "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "14\n", nil).
+				ExpectGitArgs([]string{"cat-file", "-p", "HEAD:image.psd"}, "binary content", nil),
+			expected: nil,
+		},
+		{
+			testName: "file too large to be a pointer isn't read",
+			ref:      "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "52428800\n", nil),
+			expected: nil,
+		},
+		{
+			testName: "file that doesn't exist at the ref",
+			ref:      "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "", errors.New("fatal: path 'image.psd' does not exist in 'HEAD'")),
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildLfsCommands(commonDeps{runner: s.runner})
+
+			assert.Equal(t, s.expected, instance.PointerAt(s.ref, "image.psd"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestLfsLargeNonLfsFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, "/repo/big.bin", make([]byte, 2000), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, "/repo/big.psd", make([]byte, 2000), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, "/repo/unstaged.bin", make([]byte, 2000), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, "/repo/small.txt", make([]byte, 10), 0o644))
+
+	files := []*models.File{
+		{Name: "big.bin", HasStagedChanges: true},
+		{Name: "big.psd", HasStagedChanges: true, IsLfs: true},
+		{Name: "unstaged.bin", HasUnstagedChanges: true},
+		{Name: "small.txt", HasStagedChanges: true},
+		{Name: "deleted.bin", HasStagedChanges: true, Deleted: true},
+	}
+
+	instance := buildLfsCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+	assert.Equal(t, []*models.File{files[0]}, instance.LargeNonLfsFiles(files, 1000))
+}
+
+func TestLfsGetLocks(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"lfs", "locks", "--json"},
+			`[{"id":"3","path":"image.psd","owner":{"name":"Jesse"},"locked_at":"2024-01-01T00:00:00Z"}]`, nil)
+	instance := buildLfsCommands(commonDeps{runner: runner})
+
+	locks, err := instance.GetLocks()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.LfsLock{{ID: "3", Path: "image.psd", Owner: "Jesse"}}, locks)
+	runner.CheckForMissingCalls()
+}
+
+func TestLfsHasLfsFilter(t *testing.T) {
+	type scenario struct {
+		testName      string
+		gitattributes string
+		path          string
+		runner        *oscommands.FakeCmdObjRunner
+		expected      bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "repo without lfs",
+			gitattributes: "",
+			path:          "image.psd",
+			runner:        oscommands.NewFakeRunner(t),
+			expected:      false,
+		},
+		{
+			testName:      "lfs file",
+			gitattributes: lfsAttributes,
+			path:          "image.psd",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-attr", "-z", "filter", "--", "image.psd"},
+					"image.psd\x00filter\x00lfs\x00", nil),
+			expected: true,
+		},
+		{
+			testName:      "file that isn't tracked by lfs",
+			gitattributes: lfsAttributes,
+			path:          "video.mp4",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-attr", "-z", "filter", "--", "video.mp4"},
+					"video.mp4\x00filter\x00unspecified\x00", nil),
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.gitattributes != "" {
+				assert.NoError(t, afero.WriteFile(fs, "/repo/.gitattributes", []byte(s.gitattributes), 0o644))
+			}
+			instance := buildLfsCommands(commonDeps{runner: s.runner, fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+			assert.Equal(t, s.expected, instance.HasLfsFilter(s.path))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package git_commands
+
+// Criteria for narrowing down the commits shown by git log, on top of filtering
+// by path. Empty fields are ignored; the others all have to match.
+type LogFilter struct {
+	Author  string // passed as --author
+	Message string // passed as --grep
+	Since   string // passed as --since
+	Until   string // passed as --until
+	// Only show commits that change the number of occurrences of this string
+	// (-S), or, if ContentIsRegex is set, whose diff has an added or removed
+	// line matching this regex (-G)
+	Content        string
+	ContentIsRegex bool
+	// Only show commits that aren't signed. git log has no option for this, so
+	// rather than being passed to git it is applied by the CommitLoader after
+	// loading the commits, and it is ignored for the reflog.
+	UnsignedOnly bool
+}
+
+func (self LogFilter) IsEmpty() bool {
+	return self.Author == "" &&
+		self.Message == "" &&
+		self.Since == "" &&
+		self.Until == "" &&
+		self.Content == "" &&
+		!self.UnsignedOnly
+}
+
+// Returns the arguments to pass to git log (or git log -g)
+func (self LogFilter) args() []string {
+	args := []string{}
+	if self.Author != "" {
+		args = append(args, "--author="+self.Author)
+	}
+	if self.Message != "" {
+		args = append(args, "--grep="+self.Message)
+	}
+	if self.Since != "" {
+		args = append(args, "--since="+self.Since)
+	}
+	if self.Until != "" {
+		args = append(args, "--until="+self.Until)
+	}
+	if self.Content != "" {
+		if self.ContentIsRegex {
+			args = append(args, "-G"+self.Content)
+		} else {
+			args = append(args, "-S"+self.Content)
+		}
+	}
+	return args
+}
@@ -0,0 +1,69 @@
+package git_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Commands for exchanging commits as email-style patches, i.e. `git
+// format-patch` and `git am`
+type MailboxCommands struct {
+	*GitCommon
+}
+
+func NewMailboxCommands(gitCommon *GitCommon) *MailboxCommands {
+	return &MailboxCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Writes one patch file per commit into dir (which is created if needed), and
+// returns the paths of the written files. The commits from oldest to newest
+// (inclusive) must form a contiguous range.
+func (self *MailboxCommands) FormatPatchToDir(oldest *models.Commit, newest *models.Commit, dir string) ([]string, error) {
+	cmdArgs := NewGitCmd("format-patch").
+		Arg("-o", dir).
+		Arg(formatPatchRange(oldest, newest)...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Returns the patches for the commits from oldest to newest (inclusive)
+// concatenated into a single mbox
+func (self *MailboxCommands) FormatPatchToMbox(oldest *models.Commit, newest *models.Commit) (string, error) {
+	cmdArgs := NewGitCmd("format-patch").
+		Arg("--stdout").
+		Arg(formatPatchRange(oldest, newest)...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+// `<oldest>^..<newest>` doesn't work if oldest is a root commit; in that case
+// we can simply take everything reachable from newest.
+func formatPatchRange(oldest *models.Commit, newest *models.Commit) []string {
+	if oldest.IsFirstCommit() {
+		return []string{"--root", newest.Sha}
+	}
+
+	return []string{oldest.Sha + "^.." + newest.Sha}
+}
+
+// Applies the patches in the given file (either a single patch or an mbox) on
+// top of HEAD, creating a commit for each. If a patch doesn't apply cleanly we
+// fall back to a three-way merge, so that the user can resolve the conflicts
+// and continue with `git am --continue`.
+func (self *MailboxCommands) ApplyMailbox(path string) error {
+	cmdArgs := NewGitCmd("am").
+		Arg("--3way").
+		Arg(path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
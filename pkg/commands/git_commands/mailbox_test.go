@@ -0,0 +1,74 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailboxFormatPatchToDir(t *testing.T) {
+	type scenario struct {
+		testName string
+		oldest   *models.Commit
+		runner   *oscommands.FakeCmdObjRunner
+		expected []string
+	}
+
+	newest := &models.Commit{Sha: "456def", Parents: []string{"123abc"}}
+
+	scenarios := []scenario{
+		{
+			testName: "oldest commit has a parent",
+			oldest:   &models.Commit{Sha: "123abc", Parents: []string{"000aaa"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "-o", "patches", "123abc^..456def"},
+					"patches/0001-one.patch\npatches/0002-two.patch\n", nil),
+			expected: []string{"patches/0001-one.patch", "patches/0002-two.patch"},
+		},
+		{
+			testName: "oldest commit is a root commit",
+			oldest:   &models.Commit{Sha: "123abc"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "-o", "patches", "--root", "456def"},
+					"patches/0001-one.patch\npatches/0002-two.patch\n", nil),
+			expected: []string{"patches/0001-one.patch", "patches/0002-two.patch"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildMailboxCommands(commonDeps{runner: s.runner})
+
+			paths, err := instance.FormatPatchToDir(s.oldest, newest, "patches")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, paths)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestMailboxFormatPatchToMbox(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"format-patch", "--stdout", "123abc^..456def"}, "From 456def Mon Sep 17 00:00:00 2001\n", nil)
+	instance := buildMailboxCommands(commonDeps{runner: runner})
+
+	mbox, err := instance.FormatPatchToMbox(
+		&models.Commit{Sha: "123abc", Parents: []string{"000aaa"}},
+		&models.Commit{Sha: "456def", Parents: []string{"123abc"}},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "From 456def Mon Sep 17 00:00:00 2001\n", mbox)
+	runner.CheckForMissingCalls()
+}
+
+func TestMailboxApplyMailbox(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"am", "--3way", "/tmp/series.mbox"}, "", nil)
+	instance := buildMailboxCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyMailbox("/tmp/series.mbox"))
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,86 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+)
+
+// The refs that notes are pushed and fetched with. We transfer all notes refs
+// rather than just the configured ones so that notes written by other tools
+// aren't lost.
+const notesRefspec = "refs/notes/*:refs/notes/*"
+
+type NotesCommands struct {
+	*GitCommon
+}
+
+func NewNotesCommands(gitCommon *GitCommon) *NotesCommands {
+	return &NotesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the refs that we load notes from: the default one (represented by an
+// empty string, so that git uses core.notesRef), followed by the ones
+// configured in git.notesRefs.
+func (self *NotesCommands) Refs() []string {
+	return notesRefs(self.UserConfig)
+}
+
+func notesRefs(userConfig *config.UserConfig) []string {
+	return append([]string{""}, userConfig.Git.NotesRefs...)
+}
+
+// Returns the note attached to the given commit, or an empty string if there is
+// none. An empty notesRef means the default notes ref.
+func (self *NotesCommands) Show(notesRef string, sha string) string {
+	cmdArgs := NewGitCmd("notes").
+		ArgIf(notesRef != "", "--ref="+notesRef).
+		Arg("show", sha).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		// git exits with an error if the commit has no note
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
+// Opens the commit's note in the user's editor, creating it if it doesn't exist
+// yet. git removes the note if it is left empty.
+func (self *NotesCommands) EditCmdObj(notesRef string, sha string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("notes").
+		ArgIf(notesRef != "", "--ref="+notesRef).
+		Arg("edit", sha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
+func (self *NotesCommands) Remove(notesRef string, sha string) error {
+	cmdArgs := NewGitCmd("notes").
+		ArgIf(notesRef != "", "--ref="+notesRef).
+		Arg("remove", sha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *NotesCommands) Push(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("push").Arg(remoteName, notesRefspec).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+func (self *NotesCommands) Fetch(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("fetch").Arg(remoteName, notesRefspec).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
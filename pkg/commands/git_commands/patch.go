@@ -1,7 +1,9 @@
 package git_commands
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
@@ -45,6 +47,9 @@ type ApplyPatchOpts struct {
 	Cached   bool
 	Index    bool
 	Reverse  bool
+	Check    bool
+	// Needed for patches without context lines
+	UnidiffZero bool
 }
 
 func (self *PatchCommands) ApplyCustomPatch(reverse bool) error {
@@ -72,12 +77,61 @@ func (self *PatchCommands) applyPatchFile(filepath string, opts ApplyPatchOpts)
 		ArgIf(opts.Cached, "--cached").
 		ArgIf(opts.Index, "--index").
 		ArgIf(opts.Reverse, "--reverse").
+		ArgIf(opts.Check, "--check").
+		ArgIf(opts.UnidiffZero, "--unidiff-zero").
 		Arg(filepath).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Applies a patch file from elsewhere, e.g. one that was saved in another clone
+// of the repo
+func (self *PatchCommands) ApplyPatchFile(filepath string, opts ApplyPatchOpts) error {
+	return self.applyPatchFile(filepath, opts)
+}
+
+// Checks which parts of the patch file wouldn't apply, without touching the
+// working tree or the index. Returns one entry per hunk that fails, as
+// '<file>:<line>', or per file that can't be patched at all, along with git's
+// reason. An empty result means the patch applies cleanly.
+func (self *PatchCommands) CheckPatchFile(filepath string, opts ApplyPatchOpts) ([]string, error) {
+	// --reject makes git carry on after the first failing hunk, so that we
+	// get to hear about all of them
+	cmdArgs := NewGitCmd("apply").
+		Arg("--check", "--reject").
+		ArgIf(opts.Cached, "--cached").
+		ArgIf(opts.Index, "--index").
+		ArgIf(opts.Reverse, "--reverse").
+		Arg(filepath).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseApplyCheckOutput(output), nil
+}
+
+func parseApplyCheckOutput(output string) []string {
+	failures := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		message, ok := strings.CutPrefix(line, "error: ")
+		if !ok || message == "while searching for:" {
+			continue
+		}
+
+		if hunk, ok := strings.CutPrefix(message, "patch failed: "); ok {
+			failures = append(failures, hunk)
+		} else {
+			failures = append(failures, message)
+		}
+	}
+
+	return failures
+}
+
 func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
 	filepath := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), time.Now().Format("Jan _2 15.04.05.000000000")+".patch")
 	self.Log.Infof("saving temporary patch to %s", filepath)
@@ -87,6 +141,243 @@ func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
 	return filepath, nil
 }
 
+// StashPatch stashes the changes in the given patch and removes them from the
+// working tree, and from the index too if they are staged. `git stash push` can
+// only stash whole files, so we create the stash commit ourselves. Its base is
+// the tree that the patch applies to (HEAD for staged changes, the index for
+// unstaged ones) so that the stash entry contains nothing but the patch.
+func (self *PatchCommands) StashPatch(message string, patch string, staged bool) error {
+	patchFilepath, err := self.SaveTemporaryPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	removeOpts := []ApplyPatchOpts{{Reverse: true}}
+	if staged {
+		removeOpts = append(removeOpts, ApplyPatchOpts{Reverse: true, Cached: true})
+	}
+
+	// Make sure we'll be able to remove the changes afterwards, so that we don't
+	// end up with them both stashed and still in the working tree
+	for _, opts := range removeOpts {
+		opts.Check = true
+		if err := self.applyPatchFile(patchFilepath, opts); err != nil {
+			return err
+		}
+	}
+
+	stashSha, err := self.createStashCommitForPatch(patchFilepath, message, staged)
+	if err != nil {
+		return err
+	}
+
+	if err := self.stash.Store(stashSha, message); err != nil {
+		return err
+	}
+
+	for _, opts := range removeOpts {
+		if err := self.applyPatchFile(patchFilepath, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *PatchCommands) createStashCommitForPatch(patchFilepath string, message string, staged bool) (string, error) {
+	headSha, err := self.revParse("HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	var baseTree, baseSha string
+	if staged {
+		baseSha = headSha
+		if baseTree, err = self.revParse("HEAD^{tree}"); err != nil {
+			return "", err
+		}
+	} else {
+		if baseTree, err = self.runForOutput(NewGitCmd("write-tree")); err != nil {
+			return "", err
+		}
+		if baseSha, err = self.commitTree(baseTree, "index on "+message, headSha); err != nil {
+			return "", err
+		}
+	}
+
+	// A stash commit's second parent holds the state of the index. We're not
+	// stashing anything from the index beyond what the patch contains, so it's
+	// the same as the base.
+	indexSha, err := self.commitTree(baseTree, "index on "+message, baseSha)
+	if err != nil {
+		return "", err
+	}
+
+	// Apply the patch on top of the base tree using a temporary index, so that
+	// the real one isn't touched
+	indexFile := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), time.Now().Format("Jan _2 15.04.05.000000000")+".index")
+	defer os.Remove(indexFile)
+	indexFileEnvVar := "GIT_INDEX_FILE=" + indexFile
+
+	if err := self.cmd.New(NewGitCmd("read-tree").Arg(baseTree).ToArgv()).
+		AddEnvVars(indexFileEnvVar).Run(); err != nil {
+		return "", err
+	}
+	if err := self.cmd.New(NewGitCmd("apply").Arg("--cached", patchFilepath).ToArgv()).
+		AddEnvVars(indexFileEnvVar).Run(); err != nil {
+		return "", err
+	}
+	workingTree, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).
+		AddEnvVars(indexFileEnvVar).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return self.commitTree(strings.TrimSpace(workingTree), message, baseSha, indexSha)
+}
+
+// Turns the staged changes into a fixup! commit for each of the given commits,
+// in turn. For each commit, selectPatch is given a diff without context lines
+// of the staged changes that haven't been committed yet against HEAD, and
+// returns the part of it that belongs in that commit's fixup, or an empty
+// string if there's nothing to commit. Whatever isn't picked stays staged.
+func (self *PatchCommands) CreateFixupCommitsFromStagedChanges(shas []string, selectPatch func(sha string, diff string) string) (err error) {
+	stagedTree, err := self.runForOutput(NewGitCmd("write-tree"))
+	if err != nil {
+		return err
+	}
+
+	// Whatever happens, the index ends up where it started, minus the changes
+	// that have made it into a commit by then. If that fails, the user needs to
+	// know, because the staged changes are only in stagedTree now.
+	defer func() {
+		if readTreeErr := self.cmd.New(NewGitCmd("read-tree").Arg(stagedTree).ToArgv()).Run(); readTreeErr != nil && err == nil {
+			err = readTreeErr
+		}
+	}()
+
+	for _, sha := range shas {
+		if err := self.cmd.New(NewGitCmd("read-tree").Arg("HEAD").ToArgv()).Run(); err != nil {
+			return err
+		}
+
+		diff, err := self.cmd.New(NewGitCmd("diff-tree").
+			Arg("--patch", "--no-color", "--no-ext-diff", "-U0", "--ignore-submodules=all", "HEAD", stagedTree).
+			ToArgv()).DontLog().RunWithOutput()
+		if err != nil {
+			return err
+		}
+
+		patch := selectPatch(sha, diff)
+		if patch == "" {
+			continue
+		}
+
+		if err := self.ApplyPatch(patch, ApplyPatchOpts{Cached: true, UnidiffZero: true}); err != nil {
+			return err
+		}
+
+		if err := self.commit.CreateFixupCommit(sha); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Takes the changes in the custom patch out of the commit at HEAD and commits
+// them on their own, followed by a commit with whatever is left of the
+// original one, keeping its message and author. The working tree is left
+// alone. Returns false if the patch contained all of the commit's changes, so
+// that there is nothing left to split off.
+func (self *PatchCommands) SplitPatchOffHeadCommit(summary string, description string) (bool, error) {
+	headSha, err := self.revParse("HEAD")
+	if err != nil {
+		return false, err
+	}
+
+	authorEnvVars, err := self.authorEnvVars(headSha)
+	if err != nil {
+		return false, err
+	}
+
+	if err := self.cmd.New(NewGitCmd("reset").Arg("--mixed", "--quiet", "HEAD^").ToArgv()).Run(); err != nil {
+		return false, err
+	}
+
+	restoreHead := func(err error) (bool, error) {
+		_ = self.cmd.New(NewGitCmd("reset").Arg("--mixed", "--quiet", headSha).ToArgv()).Run()
+		return false, err
+	}
+
+	if err := self.ApplyPatch(self.PatchBuilder.PatchToApply(false), ApplyPatchOpts{Cached: true}); err != nil {
+		return restoreHead(err)
+	}
+
+	if err := self.commit.CommitCmdObj(summary, description).AddEnvVars(authorEnvVars...).Run(); err != nil {
+		return restoreHead(err)
+	}
+
+	if err := self.cmd.New(NewGitCmd("read-tree").Arg(headSha).ToArgv()).Run(); err != nil {
+		return false, err
+	}
+
+	remainingTree, err := self.revParse(headSha + "^{tree}")
+	if err != nil {
+		return false, err
+	}
+	newTree, err := self.revParse("HEAD^{tree}")
+	if err != nil {
+		return false, err
+	}
+	if remainingTree == newTree {
+		return false, nil
+	}
+
+	if err := self.cmd.New(NewGitCmd("commit").Arg("--no-verify", "--reuse-message", headSha).ToArgv()).Run(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Returns the environment variables that make git use the author and author
+// date of the given commit for a new one
+func (self *PatchCommands) authorEnvVars(sha string) ([]string, error) {
+	output, err := self.runForOutput(NewGitCmd("show").Arg("--no-patch", "--format=%an%x00%ae%x00%ad", "--date=raw", sha))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(output, "\x00")
+	if len(fields) != 3 {
+		return nil, errors.New("unexpected git output")
+	}
+
+	return []string{
+		"GIT_AUTHOR_NAME=" + fields[0],
+		"GIT_AUTHOR_EMAIL=" + fields[1],
+		"GIT_AUTHOR_DATE=" + fields[2],
+	}, nil
+}
+
+func (self *PatchCommands) revParse(ref string) (string, error) {
+	return self.runForOutput(NewGitCmd("rev-parse").Arg(ref))
+}
+
+func (self *PatchCommands) commitTree(tree string, message string, parents ...string) (string, error) {
+	cmd := NewGitCmd("commit-tree").Arg(tree)
+	for _, parent := range parents {
+		cmd.Arg("-p", parent)
+	}
+	return self.runForOutput(cmd.Arg("-m", message))
+}
+
+func (self *PatchCommands) runForOutput(cmd *GitCommandBuilder) (string, error) {
+	output, err := self.cmd.New(cmd.ToArgv()).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
 // DeletePatchesFromCommit applies a patch in reverse for a commit
 func (self *PatchCommands) DeletePatchesFromCommit(commits []*models.Commit, commitIndex int) error {
 	if err := self.rebase.BeginInteractiveRebaseForCommit(commits, commitIndex, false); err != nil {
@@ -0,0 +1,61 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchCheckPatchFile(t *testing.T) {
+	type scenario struct {
+		testName         string
+		opts             ApplyPatchOpts
+		runner           *oscommands.FakeCmdObjRunner
+		expectedFailures []string
+		expectedError    string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "applies cleanly",
+			opts:     ApplyPatchOpts{},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"apply", "--check", "--reject", "fix.patch"}, "", nil),
+			expectedFailures: []string{},
+		},
+		{
+			testName: "failing hunks and missing file",
+			opts:     ApplyPatchOpts{Index: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"apply", "--check", "--reject", "--index", "fix.patch"},
+					"error: while searching for:\nh\ni\n\nerror: patch failed: file:1\nerror: while searching for:\nj\n\nerror: patch failed: file:8\nerror: other: No such file or directory\n",
+					nil),
+			expectedFailures: []string{"file:1", "file:8", "other: No such file or directory"},
+		},
+		{
+			testName: "corrupt patch",
+			opts:     ApplyPatchOpts{},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"apply", "--check", "--reject", "fix.patch"}, "", errors.New("error: corrupt patch at line 3")),
+			expectedError: "error: corrupt patch at line 3",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildPatchCommands(commonDeps{runner: s.runner})
+
+			failures, err := instance.CheckPatchFile("fix.patch", s.opts)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedFailures, failures)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package git_commands
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type RangeDiffCommands struct {
+	*GitCommon
+}
+
+func NewRangeDiffCommands(gitCommon *GitCommon) *RangeDiffCommands {
+	return &RangeDiffCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// An entry of a branch's reflog, e.g. 'feature@{1}'
+type BranchReflogEntry struct {
+	Ref      string
+	ShortSha string
+	Subject  string
+}
+
+// Compares the commits of oldRef that aren't in newRef with the commits of
+// newRef that aren't in oldRef, e.g. a branch before and after a rebase.
+func (self *RangeDiffCommands) GetPairs(oldRef string, newRef string) ([]*models.RangeDiffPair, error) {
+	cmdArgs := NewGitCmd("range-diff").
+		Arg("--no-color", "--no-patch").
+		Arg(oldRef + "..." + newRef).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRangeDiffPairs(output), nil
+}
+
+// Lines look like this (the indices are right-aligned, so they may have
+// leading spaces if there are more than 9 commits):
+//
+//	1:  2d6a9bb = 1:  55fcfb6 add a
+//	2:  b607006 ! 2:  3b87726 add b
+//	3:  f24780e < -:  ------- add c
+//	-:  ------- > 3:  4175569 add d
+var rangeDiffPairRegex = regexp.MustCompile(`^\s*(\d+|-):\s+([0-9a-f]+|-+)\s+([=!<>])\s+(\d+|-):\s+([0-9a-f]+|-+)\s(.*)$`)
+
+func parseRangeDiffPairs(output string) []*models.RangeDiffPair {
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (*models.RangeDiffPair, bool) {
+		match := rangeDiffPairRegex.FindStringSubmatch(line)
+		if match == nil {
+			return nil, false
+		}
+
+		oldIndex, oldSha := parseRangeDiffSide(match[1], match[2])
+		newIndex, newSha := parseRangeDiffSide(match[4], match[5])
+
+		status := models.RangeDiffStatusEqual
+		switch match[3] {
+		case "!":
+			status = models.RangeDiffStatusModified
+		case "<":
+			status = models.RangeDiffStatusRemoved
+		case ">":
+			status = models.RangeDiffStatusAdded
+		}
+
+		return &models.RangeDiffPair{
+			OldIndex: oldIndex,
+			OldSha:   oldSha,
+			NewIndex: newIndex,
+			NewSha:   newSha,
+			Status:   status,
+			Name:     strings.TrimSpace(match[6]),
+		}, true
+	})
+}
+
+// a missing side is shown as '-:  -------'
+func parseRangeDiffSide(index string, sha string) (int, string) {
+	if index == "-" {
+		return 0, ""
+	}
+
+	i, _ := strconv.Atoi(index)
+	return i, sha
+}
+
+// Shows the interdiff between the two commits of a pair
+func (self *RangeDiffCommands) ShowPairCmdObj(pair *models.RangeDiffPair) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("range-diff").
+		Arg("--color="+self.UserConfig.Git.Paging.ColorArg).
+		Arg(pair.OldSha+"^!", pair.NewSha+"^!").
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
+// Returns the previous positions of the branch, most recent first, excluding
+// the current one
+func (self *RangeDiffCommands) GetBranchReflog(branchName string) ([]*BranchReflogEntry, error) {
+	cmdArgs := NewGitCmd("reflog").
+		Arg("show", "--format=%gd%x00%h%x00%gs", "refs/heads/"+branchName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (*BranchReflogEntry, bool) {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) < 3 {
+			return nil, false
+		}
+
+		return &BranchReflogEntry{
+			Ref:      fields[0],
+			ShortSha: fields[1],
+			Subject:  fields[2],
+		}, true
+	})
+
+	if len(entries) > 0 {
+		entries = entries[1:]
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,87 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeDiffGetPairs(t *testing.T) {
+	type scenario struct {
+		testName      string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedPairs []*models.RangeDiffPair
+		expectedError string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "all kinds of pairs",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"range-diff", "--no-color", "--no-patch", "origin/feature...feature"},
+					` 1:  2d6a9bb =  1:  55fcfb6 add a
+ 2:  b607006 !  2:  3b87726 add b
+ 3:  f24780e <  -:  ------- add c
+ -:  ------- >  3:  4175569 add d
+10:  0123abc = 10:  4567def   leading spaces are kept out of the name
+`, nil),
+			expectedPairs: []*models.RangeDiffPair{
+				{OldIndex: 1, OldSha: "2d6a9bb", NewIndex: 1, NewSha: "55fcfb6", Status: models.RangeDiffStatusEqual, Name: "add a"},
+				{OldIndex: 2, OldSha: "b607006", NewIndex: 2, NewSha: "3b87726", Status: models.RangeDiffStatusModified, Name: "add b"},
+				{OldIndex: 3, OldSha: "f24780e", NewIndex: 0, NewSha: "", Status: models.RangeDiffStatusRemoved, Name: "add c"},
+				{OldIndex: 0, OldSha: "", NewIndex: 3, NewSha: "4175569", Status: models.RangeDiffStatusAdded, Name: "add d"},
+				{OldIndex: 10, OldSha: "0123abc", NewIndex: 10, NewSha: "4567def", Status: models.RangeDiffStatusEqual, Name: "leading spaces are kept out of the name"},
+			},
+		},
+		{
+			testName: "no differences",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"range-diff", "--no-color", "--no-patch", "origin/feature...feature"}, "", nil),
+			expectedPairs: []*models.RangeDiffPair{},
+		},
+		{
+			testName: "error",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"range-diff", "--no-color", "--no-patch", "origin/feature...feature"}, "", errors.New("unknown revision")),
+			expectedPairs: nil,
+			expectedError: "unknown revision",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRangeDiffCommands(commonDeps{runner: s.runner})
+
+			pairs, err := instance.GetPairs("origin/feature", "feature")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.EqualValues(t, s.expectedPairs, pairs)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRangeDiffGetBranchReflog(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"reflog", "show", "--format=%gd%x00%h%x00%gs", "refs/heads/feature"},
+			"feature@{0}\x003b87726\x00rebase (finish): refs/heads/feature onto 1234567\n"+
+				"feature@{1}\x00b607006\x00commit: add b\n"+
+				"feature@{2}\x002d6a9bb\x00branch: Created from HEAD\n", nil)
+
+	instance := buildRangeDiffCommands(commonDeps{runner: runner})
+
+	entries, err := instance.GetBranchReflog("feature")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []*BranchReflogEntry{
+		{Ref: "feature@{1}", ShortSha: "b607006", Subject: "commit: add b"},
+		{Ref: "feature@{2}", ShortSha: "2d6a9bb", Subject: "branch: Created from HEAD"},
+	}, entries)
+	runner.CheckForMissingCalls()
+}
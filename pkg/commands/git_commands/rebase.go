@@ -200,6 +200,7 @@ type PrepareInteractiveRebaseCommandOpts struct {
 	instruction                daemon.Instruction
 	overrideEditor             bool
 	keepCommitsThatBecomeEmpty bool
+	updateRefs                 bool
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
@@ -215,6 +216,7 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 		ArgIf(opts.keepCommitsThatBecomeEmpty && self.version.IsAtLeast(2, 26, 0), "--empty=keep").
 		Arg("--no-autosquash").
 		ArgIf(self.version.IsAtLeast(2, 22, 0), "--rebase-merges").
+		ArgIf(opts.updateRefs, "--update-refs").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
 		Arg(opts.baseShaOrRoot).
 		ToArgv()
@@ -362,6 +364,20 @@ func (self *RebaseCommands) RebaseBranch(branchName string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{baseShaOrRoot: branchName}).Run()
 }
 
+// RebaseStack rebases the current branch onto the given ref, moving along all
+// other local branches that point into the rebased commits, whether or not
+// rebase.updateRefs is configured
+func (self *RebaseCommands) RebaseStack(onto string) error {
+	if !self.version.IsAtLeast(2, 38, 0) {
+		return errors.New(self.Tr.RebaseStackRequiresNewerGit)
+	}
+
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot: onto,
+		updateRefs:    true,
+	}).Run()
+}
+
 func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string, baseCommit string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseShaOrRoot: baseCommit,
@@ -471,6 +487,39 @@ func (self *RebaseCommands) CherryPickCommits(commits []*models.Commit) error {
 	}).Run()
 }
 
+// MoveCommitsToBranch moves the commits between startIdx and endIdx onto the
+// tip of another branch and drops them from the current one, without checking
+// the other branch out. It's all done in a single rebase, so if a commit
+// doesn't apply cleanly on the other branch the rebase stops to let the user
+// resolve the conflicts, and aborting it leaves both branches as they were.
+// That relies on update-ref todos, so it needs git 2.38.
+func (self *RebaseCommands) MoveCommitsToBranch(commits []*models.Commit, startIdx int, endIdx int, branchName string) error {
+	if !self.version.IsAtLeast(2, 38, 0) {
+		return errors.New(self.Tr.MoveToBranchRequiresNewerGit)
+	}
+
+	baseSha := getBaseShaOrRoot(commits, endIdx+1)
+	if baseSha == "--root" {
+		return errors.New(self.Tr.CantMoveFirstCommitToExistingBranch)
+	}
+
+	branchSha, err := self.cmd.New(NewGitCmd("rev-parse").Arg("--verify", "refs/heads/"+branchName).ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	shas := lo.Map(commits[startIdx:endIdx+1], func(commit *models.Commit, _ int) string { return commit.Sha })
+
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot:              baseSha,
+		overrideEditor:             true,
+		keepCommitsThatBecomeEmpty: true,
+		instruction: daemon.NewMoveCommitsToBranchInstruction(
+			lo.Reverse(shas), branchName, strings.TrimSpace(branchSha), baseSha,
+		),
+	}).Run()
+}
+
 // CherryPickCommitsDuringRebase simply prepends the given commits to the existing git-rebase-todo file
 func (self *RebaseCommands) CherryPickCommitsDuringRebase(commits []*models.Commit) error {
 	todoLines := lo.Map(commits, func(commit *models.Commit, _ int) daemon.TodoLine {
@@ -75,6 +75,44 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	}
 }
 
+func TestRebaseRebaseStack(t *testing.T) {
+	type scenario struct {
+		testName   string
+		gitVersion *GitVersion
+		runner     *oscommands.FakeCmdObjRunner
+		test       func(error)
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "rebase with update-refs",
+			gitVersion: &GitVersion{2, 38, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "--update-refs", "origin/master"}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName:   "git too old for update-refs",
+			gitVersion: &GitVersion{2, 37, 9, ""},
+			runner:     oscommands.NewFakeRunner(t),
+			test: func(err error) {
+				assert.EqualError(t, err, "Rebasing a branch stack requires git 2.38 or later.")
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+			s.test(instance.RebaseStack("origin/master"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 // TestRebaseSkipEditorCommand confirms that SkipEditorCommand injects
 // environment variables that suppress an interactive editor
 func TestRebaseSkipEditorCommand(t *testing.T) {
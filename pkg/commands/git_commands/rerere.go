@@ -0,0 +1,223 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/spf13/afero"
+)
+
+// Rerere ("reuse recorded resolution") records how conflicts were resolved and
+// resolves the same conflicts automatically when they come up again.
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+func (self *RerereCommands) cacheDir() string {
+	return filepath.Join(self.repoPaths.RepoGitDirPath(), "rr-cache")
+}
+
+func (self *RerereCommands) IsEnabled() bool {
+	enabled, isSet := self.config.GetRerereEnabled()
+	if isSet {
+		return enabled
+	}
+
+	exists, err := afero.DirExists(self.Fs, self.cacheDir())
+	return err == nil && exists
+}
+
+// GetRemainingPaths returns the conflicted paths that rerere did not resolve.
+// Any other conflicted path has been resolved from a recorded resolution.
+func (self *RerereCommands) GetRemainingPaths() ([]string, error) {
+	cmdArgs := NewGitCmd("rerere").Arg("remaining").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Forget drops the recorded resolution for a conflicted path and brings back
+// the conflict markers, so that the conflict can be resolved again. Rerere
+// records the new resolution once the path is resolved.
+func (self *RerereCommands) Forget(path string) error {
+	forgetArgs := NewGitCmd("rerere").Arg("forget", "--", path).ToArgv()
+	if err := self.cmd.New(forgetArgs).Run(); err != nil {
+		return err
+	}
+
+	checkoutArgs := NewGitCmd("checkout").Arg("-m", "--", path).ToArgv()
+	return self.cmd.New(checkoutArgs).Run()
+}
+
+// GetResolutions returns the contents of the rerere cache, most recently used
+// first
+func (self *RerereCommands) GetResolutions() ([]*models.RerereResolution, error) {
+	hashDirs, err := afero.ReadDir(self.Fs, self.cacheDir())
+	if err != nil {
+		if exists, _ := afero.DirExists(self.Fs, self.cacheDir()); !exists {
+			return []*models.RerereResolution{}, nil
+		}
+		return nil, err
+	}
+
+	resolutions := []*models.RerereResolution{}
+	for _, hashDir := range hashDirs {
+		if !hashDir.IsDir() {
+			continue
+		}
+
+		files, err := afero.ReadDir(self.Fs, filepath.Join(self.cacheDir(), hashDir.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		postimages := map[string]bool{}
+		for _, file := range files {
+			if strings.HasPrefix(file.Name(), "postimage") {
+				postimages[strings.TrimPrefix(file.Name(), "postimage")] = true
+			}
+		}
+
+		for _, file := range files {
+			if !strings.HasPrefix(file.Name(), "preimage") {
+				continue
+			}
+
+			variant, ok := parseVariantSuffix(strings.TrimPrefix(file.Name(), "preimage"))
+			if !ok {
+				continue
+			}
+
+			resolution := &models.RerereResolution{
+				Hash:     hashDir.Name(),
+				Variant:  variant,
+				LastUsed: file.ModTime(),
+			}
+			resolution.HasPostimage = postimages[resolution.FileSuffix()]
+			if resolution.HasPostimage {
+				// git touches the postimage whenever it reuses the resolution
+				if info, err := self.Fs.Stat(self.imagePath(resolution, "postimage")); err == nil {
+					resolution.LastUsed = info.ModTime()
+				}
+			}
+
+			preimage, err := afero.ReadFile(self.Fs, self.imagePath(resolution, "preimage"))
+			if err != nil {
+				return nil, err
+			}
+			resolution.Summary = conflictSummary(string(preimage))
+
+			resolutions = append(resolutions, resolution)
+		}
+	}
+
+	sort.SliceStable(resolutions, func(i, j int) bool {
+		return resolutions[i].LastUsed.After(resolutions[j].LastUsed)
+	})
+
+	return resolutions, nil
+}
+
+// the suffix is either empty or a dot followed by the variant number
+func parseVariantSuffix(suffix string) (int, bool) {
+	if suffix == "" {
+		return 0, true
+	}
+
+	if !strings.HasPrefix(suffix, ".") {
+		return 0, false
+	}
+
+	variant, err := strconv.Atoi(suffix[1:])
+	if err != nil {
+		return 0, false
+	}
+
+	return variant, true
+}
+
+// returns the first non-blank line of our side of the first conflict
+func conflictSummary(preimage string) string {
+	inConflict := false
+	for _, line := range utils.SplitLines(preimage) {
+		if strings.HasPrefix(line, "<<<<<<<") {
+			inConflict = true
+			continue
+		}
+
+		if !inConflict {
+			continue
+		}
+
+		if strings.HasPrefix(line, "=======") || strings.HasPrefix(line, "|||||||") {
+			break
+		}
+
+		if strings.TrimSpace(line) != "" {
+			return strings.TrimSpace(line)
+		}
+	}
+
+	return ""
+}
+
+func (self *RerereCommands) imagePath(resolution *models.RerereResolution, image string) string {
+	return filepath.Join(self.cacheDir(), resolution.Hash, image+resolution.FileSuffix())
+}
+
+// Preimage returns the conflict as rerere recorded it, with conflict markers
+func (self *RerereCommands) Preimage(resolution *models.RerereResolution) (string, error) {
+	content, err := afero.ReadFile(self.Fs, self.imagePath(resolution, "preimage"))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// ResolutionDiffCmdObj shows how the conflict was resolved, i.e. the diff from
+// the conflicted hunks to the resolved ones
+func (self *RerereCommands) ResolutionDiffCmdObj(resolution *models.RerereResolution) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--no-index", "--no-ext-diff").
+		Arg("--color="+self.UserConfig.Git.Paging.ColorArg).
+		Arg("--", self.imagePath(resolution, "preimage"), self.imagePath(resolution, "postimage")).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
+// DeleteResolution removes a resolution from the rerere cache, so that the
+// conflict won't be resolved automatically any more. This is what `git rerere
+// forget` does, except that it doesn't need the conflict to be happening.
+func (self *RerereCommands) DeleteResolution(resolution *models.RerereResolution) error {
+	for _, image := range []string{"preimage", "postimage", "thisimage"} {
+		if err := self.Fs.Remove(self.imagePath(resolution, image)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// git doesn't mind empty hash directories, but there's no point keeping them
+	hashDir := filepath.Join(self.cacheDir(), resolution.Hash)
+	if empty, err := afero.IsEmpty(self.Fs, hashDir); err == nil && empty {
+		return self.Fs.Remove(hashDir)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package git_commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereIsEnabled(t *testing.T) {
+	type scenario struct {
+		testName      string
+		gitConfig     map[string]string
+		cacheExists   bool
+		expectEnabled bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "enabled in config",
+			gitConfig:     map[string]string{"rerere.enabled": "true"},
+			expectEnabled: true,
+		},
+		{
+			testName:      "disabled in config even though the cache exists",
+			gitConfig:     map[string]string{"rerere.enabled": "false"},
+			cacheExists:   true,
+			expectEnabled: false,
+		},
+		{
+			testName:      "unset, with a cache",
+			cacheExists:   true,
+			expectEnabled: true,
+		},
+		{
+			testName:      "unset, without a cache",
+			expectEnabled: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.cacheExists {
+				assert.NoError(t, fs.MkdirAll(".git/.git/rr-cache", 0o755))
+			}
+
+			instance := buildRerereCommands(commonDeps{
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+				fs:        fs,
+			})
+
+			assert.Equal(t, s.expectEnabled, instance.IsEnabled())
+		})
+	}
+}
+
+func TestRerereGetRemainingPaths(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rerere", "remaining"}, "file1\ndir/file2\n", nil)
+	instance := buildRerereCommands(commonDeps{runner: runner})
+
+	paths, err := instance.GetRemainingPaths()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file1", "dir/file2"}, paths)
+	runner.CheckForMissingCalls()
+}
+
+func TestRerereForget(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rerere", "forget", "--", "file1"}, "Updated preimage for 'file1'\nForgot resolution for 'file1'\n", nil).
+		ExpectGitArgs([]string{"checkout", "-m", "--", "file1"}, "", nil)
+	instance := buildRerereCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Forget("file1"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRerereGetResolutions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	writeFile := func(path string, content string, modTime time.Time) {
+		assert.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+		assert.NoError(t, fs.Chtimes(path, modTime, modTime))
+	}
+
+	preimage := "a\n<<<<<<<\n\nours\n=======\ntheirs\n>>>>>>>\nc\n"
+	writeFile(".git/.git/rr-cache/aaaa1111/preimage", preimage, older)
+	writeFile(".git/.git/rr-cache/aaaa1111/postimage", "a\nresolved\nc\n", older)
+	writeFile(".git/.git/rr-cache/aaaa1111/preimage.1", "<<<<<<<\nother\n=======\nconflict\n>>>>>>>\n", older)
+	writeFile(".git/.git/rr-cache/bbbb2222/preimage", "<<<<<<<\nunresolved\n=======\nconflict\n>>>>>>>\n", newer)
+	writeFile(".git/.git/rr-cache/bbbb2222/thisimage", "", newer)
+
+	instance := buildRerereCommands(commonDeps{fs: fs})
+
+	resolutions, err := instance.GetResolutions()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.RerereResolution{
+		{Hash: "bbbb2222", Variant: 0, Summary: "unresolved", HasPostimage: false, LastUsed: newer},
+		{Hash: "aaaa1111", Variant: 0, Summary: "ours", HasPostimage: true, LastUsed: older},
+		{Hash: "aaaa1111", Variant: 1, Summary: "other", HasPostimage: false, LastUsed: older},
+	}, lo.Map(resolutions, func(r *models.RerereResolution, _ int) *models.RerereResolution {
+		r.LastUsed = r.LastUsed.UTC()
+		return r
+	}))
+}
+
+func TestRerereGetResolutionsWithoutCache(t *testing.T) {
+	instance := buildRerereCommands(commonDeps{fs: afero.NewMemMapFs()})
+
+	resolutions, err := instance.GetResolutions()
+	assert.NoError(t, err)
+	assert.Empty(t, resolutions)
+}
+
+func TestRerereDeleteResolution(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, path := range []string{
+		".git/.git/rr-cache/aaaa1111/preimage",
+		".git/.git/rr-cache/aaaa1111/postimage",
+		".git/.git/rr-cache/aaaa1111/preimage.1",
+		".git/.git/rr-cache/bbbb2222/preimage",
+	} {
+		assert.NoError(t, afero.WriteFile(fs, path, []byte("content"), 0o644))
+	}
+
+	instance := buildRerereCommands(commonDeps{fs: fs})
+
+	assert.NoError(t, instance.DeleteResolution(&models.RerereResolution{Hash: "aaaa1111", Variant: 0}))
+	assert.NoError(t, instance.DeleteResolution(&models.RerereResolution{Hash: "bbbb2222", Variant: 0}))
+
+	for path, expectExists := range map[string]bool{
+		".git/.git/rr-cache/aaaa1111/preimage":   false,
+		".git/.git/rr-cache/aaaa1111/postimage":  false,
+		".git/.git/rr-cache/aaaa1111/preimage.1": true,
+		".git/.git/rr-cache/bbbb2222":            false,
+	} {
+		exists, err := afero.Exists(fs, path)
+		assert.NoError(t, err)
+		assert.Equal(t, expectExists, exists, path)
+	}
+}
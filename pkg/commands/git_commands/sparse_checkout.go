@@ -0,0 +1,120 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// We only support cone-mode sparse checkouts, which is what `git sparse-checkout
+// set` creates by default, and which is the only mode in which the patterns are
+// plain directories.
+type SparseCheckoutCommands struct {
+	*GitCommon
+}
+
+func NewSparseCheckoutCommands(gitCommon *GitCommon) *SparseCheckoutCommands {
+	return &SparseCheckoutCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// MightBeEnabled rules out the common case of a repo that has never been a
+// sparse checkout without having to run git. The patterns file is kept around
+// when sparse checkout is disabled, so a true result still needs to be
+// confirmed with GetDirectories.
+func (self *SparseCheckoutCommands) MightBeEnabled() bool {
+	exists, err := afero.Exists(self.Fs, filepath.Join(self.repoPaths.WorktreeGitDirPath(), "info", "sparse-checkout"))
+	return err == nil && exists
+}
+
+// GetDirectories returns the directories included in the sparse checkout. The
+// boolean is false if the worktree is not a sparse checkout at all, in which
+// case everything is checked out.
+func (self *SparseCheckoutCommands) GetDirectories() ([]*models.SparseCheckoutDirectory, bool, error) {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("list").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		if strings.Contains(err.Error(), "not sparse") {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return lo.Map(utils.SplitLines(output), func(path string, _ int) *models.SparseCheckoutDirectory {
+		return &models.SparseCheckoutDirectory{Path: path}
+	}), true, nil
+}
+
+// Add adds directories to an existing sparse checkout
+func (self *SparseCheckoutCommands) Add(paths []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("add", "--").Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Set replaces the directories of the sparse checkout, enabling it if needed.
+// Passing no paths leaves only the files in the root directory checked out.
+func (self *SparseCheckoutCommands) Set(paths []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("set", "--cone", "--").Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Disable checks out all files again. The directories are kept around so that
+// the sparse checkout can be enabled again later with Enable.
+func (self *SparseCheckoutCommands) Disable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("disable").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Enable turns a previously disabled sparse checkout back on, using the
+// directories it had before
+func (self *SparseCheckoutCommands) Enable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("init", "--cone").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Reapply updates the working tree to match the sparse checkout again, e.g.
+// after resolving conflicts in files outside of it
+func (self *SparseCheckoutCommands) Reapply() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("reapply").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// GetAllDirectories returns every directory in HEAD, whether it is checked out
+// or not
+func (self *SparseCheckoutCommands) GetAllDirectories() ([]string, error) {
+	cmdArgs := NewGitCmd("ls-tree").Arg("-r", "-d", "--name-only", "HEAD").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// ListFilesCmdObj lists the files in HEAD that a directory of the sparse
+// checkout brings in
+func (self *SparseCheckoutCommands) ListFilesCmdObj(path string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("ls-tree").Arg("-r", "--name-only", "HEAD", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
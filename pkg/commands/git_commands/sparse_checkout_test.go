@@ -0,0 +1,79 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutGetDirectories(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedDirs    []*models.SparseCheckoutDirectory
+		expectedEnabled bool
+		expectedErr     error
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "sparse checkout with directories",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "a/x\nb\n", nil),
+			expectedDirs: []*models.SparseCheckoutDirectory{
+				{Path: "a/x"},
+				{Path: "b"},
+			},
+			expectedEnabled: true,
+		},
+		{
+			testName: "sparse checkout with only the root directory",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "", nil),
+			expectedDirs:    []*models.SparseCheckoutDirectory{},
+			expectedEnabled: true,
+		},
+		{
+			testName: "not a sparse checkout",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "", errors.New("fatal: this worktree is not sparse")),
+			expectedDirs:    nil,
+			expectedEnabled: false,
+		},
+		{
+			testName: "other error",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "", errors.New("error")),
+			expectedDirs:    nil,
+			expectedEnabled: false,
+			expectedErr:     errors.New("error"),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner})
+
+			dirs, enabled, err := instance.GetDirectories()
+			assert.Equal(t, s.expectedDirs, dirs)
+			assert.Equal(t, s.expectedEnabled, enabled)
+			assert.Equal(t, s.expectedErr, err)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSparseCheckoutSet(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "--", "a/x", "b"}, "", nil).
+		ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "--"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Set([]string{"a/x", "b"}))
+	assert.NoError(t, instance.Set(nil))
+	runner.CheckForMissingCalls()
+}
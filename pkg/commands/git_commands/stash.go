@@ -52,6 +52,16 @@ func (self *StashCommands) Apply(index int) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// StashBranch creates a new branch at the commit the stash entry was created
+// from, checks it out and applies the stash entry there, dropping it if it
+// applied cleanly
+func (self *StashCommands) StashBranch(branchName string, index int) error {
+	cmdArgs := NewGitCmd("stash").Arg("branch", branchName, fmt.Sprintf("stash@{%d}", index)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // Push push stash
 func (self *StashCommands) Push(message string) error {
 	cmdArgs := NewGitCmd("stash").Arg("push", "-m", message).
@@ -85,6 +95,9 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) oscommands.ICmdObj {
 		Arg("-p").
 		Arg("--stat").
 		Arg(fmt.Sprintf("--color=%s", self.UserConfig.Git.Paging.ColorArg)).
+		// Untracked files are stored in the stash's third parent, which `stash
+		// show` ignores unless we ask for it
+		ArgIf(self.version.IsAtLeast(2, 32, 0), "--include-untracked").
 		Arg(fmt.Sprintf("--unified=%d", self.AppState.DiffContextSize)).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
 		Arg(fmt.Sprintf("stash@{%d}", index)).
@@ -178,6 +191,17 @@ func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
 	).Run()
 }
 
+// StashPaths stashes the changes of the given files or directories only, leaving
+// all other changes in place. Untracked files are included so that it works the
+// same for new files as it does for modified ones.
+func (self *StashCommands) StashPaths(message string, paths []string) error {
+	cmdArgs := NewGitCmd("stash").Arg("push", "--include-untracked", "-m", message, "--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *StashCommands) Rename(index int, message string) error {
 	sha, err := self.Sha(index)
 	if err != nil {
@@ -44,6 +44,24 @@ func TestStashSave(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "branch", "new-branch", "stash@{2}"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashBranch("new-branch", 2))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashPaths(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--include-untracked", "-m", "A stash message", "--", "file1", "dir/"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashPaths("A stash message", []string{"file1", "dir/"}))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashStore(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -103,6 +121,7 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 		index            int
 		contextSize      int
 		ignoreWhitespace bool
+		gitVersion       *GitVersion
 		expected         []string
 	}
 
@@ -128,6 +147,14 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			ignoreWhitespace: true,
 			expected:         []string{"git", "-C", "/path/to/worktree", "stash", "show", "-p", "--stat", "--color=always", "--unified=3", "--ignore-all-space", "stash@{5}"},
 		},
+		{
+			testName:         "Show untracked files with git 2.32 or later",
+			index:            5,
+			contextSize:      3,
+			ignoreWhitespace: false,
+			gitVersion:       &GitVersion{2, 32, 0, ""},
+			expected:         []string{"git", "-C", "/path/to/worktree", "stash", "show", "-p", "--stat", "--color=always", "--include-untracked", "--unified=3", "stash@{5}"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -140,7 +167,7 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			repoPaths := RepoPaths{
 				worktreePath: "/path/to/worktree",
 			}
-			instance := buildStashCommands(commonDeps{userConfig: userConfig, appState: appState, repoPaths: &repoPaths})
+			instance := buildStashCommands(commonDeps{userConfig: userConfig, appState: appState, repoPaths: &repoPaths, gitVersion: s.gitVersion})
 
 			cmdStr := instance.ShowStashEntryCmdObj(s.index).Args()
 			assert.Equal(t, s.expected, cmdStr)
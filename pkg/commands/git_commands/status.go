@@ -38,6 +38,10 @@ func (self *StatusCommands) RebaseMode() (enums.RebaseMode, error) {
 }
 
 func (self *StatusCommands) WorkingTreeState() enums.RebaseMode {
+	applying, _ := self.IsApplyingMailbox()
+	if applying {
+		return enums.REBASE_MODE_APPLYING
+	}
 	rebaseMode, _ := self.RebaseMode()
 	if rebaseMode != enums.REBASE_MODE_NONE {
 		return enums.REBASE_MODE_REBASING
@@ -66,7 +70,19 @@ func IsBareRepo(osCommand *oscommands.OSCommand) (bool, error) {
 }
 
 func (self *StatusCommands) IsInNormalRebase() (bool, error) {
-	return self.os.FileExists(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-apply"))
+	exists, err := self.os.FileExists(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-apply"))
+	if err != nil || !exists {
+		return false, err
+	}
+
+	// `git am` uses the same directory, so we need to tell the two apart
+	applying, err := self.IsApplyingMailbox()
+	return !applying, err
+}
+
+// IsApplyingMailbox states whether we are in the middle of a `git am`
+func (self *StatusCommands) IsApplyingMailbox() (bool, error) {
+	return self.os.FileExists(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-apply", "applying"))
 }
 
 func (self *StatusCommands) IsInInteractiveRebase() (bool, error) {
@@ -305,6 +305,34 @@ func (self *WorkingTreeCommands) CheckoutFile(commitSha, fileName string) error
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// RecreateConflict writes the conflicts of an unmerged file again, in the
+// given conflict style (merge, diff3 or zdiff3). This throws away any
+// resolutions already made in the file.
+func (self *WorkingTreeCommands) RecreateConflict(fileName string, conflictStyle string) error {
+	cmdArgs := NewGitCmd("checkout").Arg("--conflict="+conflictStyle, "--", fileName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// CheckoutConflictVersion replaces an unmerged file with one side's version of
+// it, where version is either "ours" or "theirs"
+func (self *WorkingTreeCommands) CheckoutConflictVersion(fileName string, version string) error {
+	cmdArgs := NewGitCmd("checkout").Arg("--"+version, "--", fileName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// RemoveConflictedFile resolves a conflict by deleting the file, both from the
+// index and the working tree
+func (self *WorkingTreeCommands) RemoveConflictedFile(fileName string) error {
+	cmdArgs := NewGitCmd("rm").Arg("--", fileName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // DiscardAnyUnstagedFileChanges discards any unstaged file changes via `git checkout -- .`
 func (self *WorkingTreeCommands) DiscardAnyUnstagedFileChanges() error {
 	cmdArgs := NewGitCmd("checkout").Arg("--", ".").
@@ -438,6 +438,33 @@ func TestWorkingTreeCheckoutFile(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeRecreateConflict(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"checkout", "--conflict=zdiff3", "--", "test.txt"}, "", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RecreateConflict("test.txt", "zdiff3"))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeCheckoutConflictVersion(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"checkout", "--theirs", "--", "test.txt"}, "", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.CheckoutConflictVersion("test.txt", "theirs"))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeRemoveConflictedFile(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rm", "--", "test.txt"}, "", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RemoveConflictedFile("test.txt"))
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeDiscardUnstagedFileChanges(t *testing.T) {
 	type scenario struct {
 		testName string
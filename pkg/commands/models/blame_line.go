@@ -0,0 +1,44 @@
+package models
+
+import "fmt"
+
+// A single line of `git blame` output for a file
+type BlameLine struct {
+	Sha             string
+	Author          string
+	AuthorTimestamp int64
+	Summary         string
+
+	// The commit before Sha that touched this file, together with the name the
+	// file had in that commit. Empty if Sha is the commit that introduced the
+	// file (or if the line isn't committed yet).
+	PreviousSha      string
+	PreviousFilename string
+
+	// The name of the file in commit Sha; this can differ from the name of the
+	// blamed file if the file was renamed since.
+	Filename string
+
+	// 1-based line number in the blamed revision of the file
+	LineNumber int
+	Content    string
+}
+
+func (self *BlameLine) ID() string {
+	return fmt.Sprintf("%s:%d", self.Sha, self.LineNumber)
+}
+
+func (self *BlameLine) Description() string {
+	return self.Content
+}
+
+// Lines that are modified in the working tree but not committed yet are
+// attributed to a commit with an all-zero SHA by git blame.
+func (self *BlameLine) IsNotCommittedYet() bool {
+	for _, ch := range self.Sha {
+		if ch != '0' {
+			return false
+		}
+	}
+	return true
+}
@@ -41,6 +41,30 @@ const (
 	DivergenceRight
 )
 
+// The outcome of verifying a commit's signature. Git distinguishes more cases
+// (see the %G? placeholder of git log), which we group by what they mean for
+// whether the commit can be trusted.
+type SignatureStatus int
+
+const (
+	// The commit is not signed
+	SignatureStatusNone SignatureStatus = iota
+	// Good signature from a trusted key
+	SignatureStatusGood
+	// Good signature that can't be fully trusted, e.g. because the key is
+	// untrusted or expired, or the signature can't be checked at all because
+	// the key is missing
+	SignatureStatusUnknown
+	// Bad signature, or one made with a revoked key
+	SignatureStatusBad
+)
+
+type CommitSignature struct {
+	Status SignatureStatus
+	Key    string // the key used to sign the commit, as given by %GK
+	Signer string // the name of the signer, as given by %GS
+}
+
 // Commit : A git commit
 type Commit struct {
 	Sha           string
@@ -53,6 +77,10 @@ type Commit struct {
 	AuthorEmail   string // something like 'jessedduffield@gmail.com'
 	UnixTimestamp int64
 	Divergence    Divergence // set to DivergenceNone unless we are showing the divergence view
+	HasNotes      bool       // true if a note is attached to the commit in any of the notes refs we load
+	// nil until the signature has been verified, which we only do when asked
+	// to, because it's slow
+	Signature *CommitSignature
 
 	// SHAs of parent commits (will be multiple if it's a merge commit)
 	Parents []string
@@ -22,7 +22,46 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
-}
+
+	// If true, the file's content is stored in Git LFS rather than in the repo
+	IsLfs bool
+
+	// If true, the worktree is a sparse checkout that doesn't include the file's
+	// directory, e.g. an untracked file that was left behind when the directory
+	// was removed from the sparse checkout
+	IsOutsideSparseCheckout bool
+
+	// If true, the file is still unmerged but rerere has already resolved its
+	// conflicts using a recorded resolution
+	IsResolvedByRerere bool
+
+	// If true, both sides changed the file but it's binary, so git couldn't
+	// write conflict markers into it and one of the two versions has to be
+	// picked as a whole
+	HasBinaryConflict bool
+}
+
+// The kinds of merge conflicts, as given by the status codes of unmerged files
+// in `git status --short`
+type ConflictType int
+
+const (
+	ConflictTypeNone ConflictType = iota
+	// UU
+	ConflictTypeBothModified
+	// AA
+	ConflictTypeBothAdded
+	// DD, e.g. when both sides renamed the file, but to different names
+	ConflictTypeBothDeleted
+	// AU, e.g. when we renamed the file and they deleted or renamed it
+	ConflictTypeAddedByUs
+	// UA, e.g. when they renamed the file and we deleted or renamed it
+	ConflictTypeAddedByThem
+	// DU
+	ConflictTypeDeletedByUs
+	// UD
+	ConflictTypeDeletedByThem
+)
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
 type IFile interface {
@@ -74,6 +113,27 @@ func (f *File) SubmoduleConfig(configs []*SubmoduleConfig) *SubmoduleConfig {
 	return nil
 }
 
+func (f *File) ConflictType() ConflictType {
+	switch f.ShortStatus {
+	case "UU":
+		return ConflictTypeBothModified
+	case "AA":
+		return ConflictTypeBothAdded
+	case "DD":
+		return ConflictTypeBothDeleted
+	case "AU":
+		return ConflictTypeAddedByUs
+	case "UA":
+		return ConflictTypeAddedByThem
+	case "DU":
+		return ConflictTypeDeletedByUs
+	case "UD":
+		return ConflictTypeDeletedByThem
+	default:
+		return ConflictTypeNone
+	}
+}
+
 func (f *File) GetHasUnstagedChanges() bool {
 	return f.HasUnstagedChanges
 }
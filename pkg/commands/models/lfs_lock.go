@@ -0,0 +1,8 @@
+package models
+
+// A lock on a file held on the Git LFS server, as listed by `git lfs locks`
+type LfsLock struct {
+	ID    string
+	Path  string
+	Owner string
+}
@@ -0,0 +1,44 @@
+package models
+
+import "fmt"
+
+type RangeDiffStatus int
+
+const (
+	// the commit is the same in both ranges
+	RangeDiffStatusEqual RangeDiffStatus = iota
+	// the commit exists in both ranges but its patch (or message) differs
+	RangeDiffStatusModified
+	// the commit only exists in the old range
+	RangeDiffStatusRemoved
+	// the commit only exists in the new range
+	RangeDiffStatusAdded
+)
+
+// A line of `git range-diff` output, matching a commit of the old range with a
+// commit of the new range. For removed and added commits, one of the two sides
+// is missing, in which case its index is 0 and its sha is empty.
+type RangeDiffPair struct {
+	OldIndex int
+	OldSha   string
+	NewIndex int
+	NewSha   string
+	Status   RangeDiffStatus
+	Name     string
+}
+
+func (self *RangeDiffPair) ID() string {
+	return fmt.Sprintf("%s-%s", self.OldSha, self.NewSha)
+}
+
+func (self *RangeDiffPair) Description() string {
+	return self.Name
+}
+
+func (self *RangeDiffPair) HasOld() bool {
+	return self.OldSha != ""
+}
+
+func (self *RangeDiffPair) HasNew() bool {
+	return self.NewSha != ""
+}
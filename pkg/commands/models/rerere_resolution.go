@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// A conflict recorded by git rerere in the rr-cache directory. Git identifies
+// conflicts by a hash of the conflicting hunks rather than by path, so that a
+// resolution can be reused wherever the same conflict shows up again. Different
+// conflicts that happen to have the same hash are stored as numbered variants
+// alongside each other (preimage.1, postimage.1, etc.).
+type RerereResolution struct {
+	Hash    string
+	Variant int
+	// The first line of our side of the conflict, to help recognise it, given
+	// that the path of the conflicted file isn't recorded
+	Summary string
+	// False if the conflict was recorded but never resolved
+	HasPostimage bool
+	LastUsed     time.Time
+}
+
+func (r *RerereResolution) ID() string {
+	return r.Hash + r.FileSuffix()
+}
+
+func (r *RerereResolution) Description() string {
+	return r.ID()
+}
+
+// FileSuffix is the suffix of this variant's files in the hash directory
+func (r *RerereResolution) FileSuffix() string {
+	if r.Variant == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(".%d", r.Variant)
+}
+
+func (r *RerereResolution) ShortHash() string {
+	if len(r.Hash) < 8 {
+		return r.Hash
+	}
+
+	return r.Hash[:8]
+}
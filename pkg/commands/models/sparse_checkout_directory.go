@@ -0,0 +1,37 @@
+package models
+
+import "strings"
+
+// A directory that is included in a cone-mode sparse checkout
+type SparseCheckoutDirectory struct {
+	Path string
+}
+
+func (d *SparseCheckoutDirectory) ID() string {
+	return d.Path
+}
+
+func (d *SparseCheckoutDirectory) Description() string {
+	return d.Path
+}
+
+// IsDirInSparseCheckoutCone tells whether the given directory (relative to the
+// repo root, with "" or "." meaning the root itself) is checked out when the
+// sparse checkout contains the given directories. In cone mode, every file that
+// sits directly in an ancestor of an included directory is checked out too, so
+// ancestors count as being in the cone.
+func IsDirInSparseCheckoutCone(dir string, dirs []*SparseCheckoutDirectory) bool {
+	if dir == "" || dir == "." {
+		return true
+	}
+
+	for _, included := range dirs {
+		if dir == included.Path ||
+			strings.HasPrefix(dir, included.Path+"/") ||
+			strings.HasPrefix(included.Path, dir+"/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -303,6 +303,23 @@ func (c *OSCommand) CopyToClipboard(str string) error {
 	return clipboard.WriteAll(str)
 }
 
+func (c *OSCommand) PasteFromClipboard() (string, error) {
+	var s string
+	var err error
+	if c.UserConfig.OS.ReadFromClipboardCmd != "" {
+		s, err = c.Cmd.NewShell(c.UserConfig.OS.ReadFromClipboardCmd).DontLog().RunWithOutput()
+	} else {
+		s, err = clipboard.ReadAll()
+	}
+	if err != nil {
+		return "", utils.WrapError(err)
+	}
+
+	// the clipboard might give us windows line endings, which git apply
+	// wouldn't accept for a patch made on unix
+	return strings.ReplaceAll(s, "\r\n", "\n"), nil
+}
+
 func (c *OSCommand) RemoveFile(path string) error {
 	msg := utils.ResolvePlaceholderString(
 		c.Tr.Log.RemoveFile,
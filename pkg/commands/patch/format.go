@@ -16,6 +16,8 @@ type patchPresenter struct {
 
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+
+	inlineDiffMode InlineDiffMode
 }
 
 // formats the patch as a plain string
@@ -41,6 +43,10 @@ func formatRangePlain(patch *Patch, startIdx int, endIdx int) string {
 type FormatViewOpts struct {
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+
+	// whether to highlight the words or characters that changed within
+	// changed lines
+	InlineDiffMode InlineDiffMode
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -54,6 +60,7 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 		patch:          patch,
 		plain:          false,
 		incLineIndices: includedLineIndices,
+		inlineDiffMode: opts.InlineDiffMode,
 	}
 	return presenter.format()
 }
@@ -103,8 +110,17 @@ func (self *patchPresenter) format() string {
 				),
 		)
 
-		for _, line := range hunk.bodyLines {
-			appendFormattedLine(line.Content, self.patchLineStyle(line))
+		inlineRanges := map[int][]inlineRange{}
+		if !self.plain {
+			inlineRanges = inlineDiffRanges(hunk.bodyLines, self.inlineDiffMode)
+		}
+
+		for i, line := range hunk.bodyLines {
+			if ranges, ok := inlineRanges[i]; ok {
+				appendLine(self.formatLineWithInlineDiff(line.Content, self.patchLineStyle(line), lineIdx, ranges))
+			} else {
+				appendFormattedLine(line.Content, self.patchLineStyle(line))
+			}
 		}
 	}
 
@@ -147,3 +163,30 @@ func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle,
 
 	return firstCharStyle.Sprint(str[:1]) + textStyle.Sprint(str[1:])
 }
+
+// Like formatLine, but additionally highlights the given ranges of the line's
+// content (i.e. everything after the first character) in reverse video
+func (self *patchPresenter) formatLineWithInlineDiff(str string, textStyle style.TextStyle, index int, ranges []inlineRange) string {
+	firstCharStyle := textStyle
+	if self.incLineIndices.Includes(index) {
+		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
+	}
+	highlightStyle := textStyle.SetReverse()
+
+	content := str[1:]
+	stringBuilder := &strings.Builder{}
+	_, _ = stringBuilder.WriteString(firstCharStyle.Sprint(str[:1]))
+	offset := 0
+	for _, r := range ranges {
+		if r.start > offset {
+			_, _ = stringBuilder.WriteString(textStyle.Sprint(content[offset:r.start]))
+		}
+		_, _ = stringBuilder.WriteString(highlightStyle.Sprint(content[r.start:r.end]))
+		offset = r.end
+	}
+	if offset < len(content) {
+		_, _ = stringBuilder.WriteString(textStyle.Sprint(content[offset:]))
+	}
+
+	return stringBuilder.String()
+}
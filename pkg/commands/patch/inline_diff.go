@@ -0,0 +1,208 @@
+package patch
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Which parts of a changed line to highlight, on top of colouring the whole
+// line: nothing, the words that changed, or the characters that changed.
+type InlineDiffMode string
+
+const (
+	InlineDiffNone InlineDiffMode = "none"
+	InlineDiffWord InlineDiffMode = "word"
+	InlineDiffChar InlineDiffMode = "char"
+)
+
+// Beyond this many token comparisons we don't bother finding the longest
+// common subsequence of two lines, and just highlight everything between
+// their common prefix and suffix
+const maxInlineDiffComparisons = 250000
+
+// A range of bytes [start, end) within a line's content, not counting the
+// leading '+' or '-'
+type inlineRange struct {
+	start int
+	end   int
+}
+
+// Pairs up each block of deleted lines with the block of added lines directly
+// following it (first line with first line and so on), and works out which
+// parts of each pair differ. Returns the ranges to highlight, keyed by the
+// index of the line within the given hunk body.
+func inlineDiffRanges(lines []*PatchLine, mode InlineDiffMode) map[int][]inlineRange {
+	result := map[int][]inlineRange{}
+	if mode != InlineDiffWord && mode != InlineDiffChar {
+		return result
+	}
+
+	for i := 0; i < len(lines); {
+		if lines[i].Kind != DELETION {
+			i++
+			continue
+		}
+
+		deletionsStart := i
+		for i < len(lines) && lines[i].Kind == DELETION {
+			i++
+		}
+		additionsStart := i
+		for i < len(lines) && lines[i].Kind == ADDITION {
+			i++
+		}
+
+		pairCount := utils.Min(additionsStart-deletionsStart, i-additionsStart)
+		for j := 0; j < pairCount; j++ {
+			oldIdx, newIdx := deletionsStart+j, additionsStart+j
+			oldRanges, newRanges, ok := diffLinePair(lines[oldIdx].Content[1:], lines[newIdx].Content[1:], mode)
+			if ok {
+				result[oldIdx] = oldRanges
+				result[newIdx] = newRanges
+			}
+		}
+	}
+
+	return result
+}
+
+// Returns the ranges of the old and the new line that changed. If the lines
+// have nothing in common, highlighting them would just add noise, so we
+// return false.
+func diffLinePair(oldLine string, newLine string, mode InlineDiffMode) ([]inlineRange, []inlineRange, bool) {
+	oldTokens := tokenize(oldLine, mode)
+	newTokens := tokenize(newLine, mode)
+
+	prefixLen := 0
+	for prefixLen < len(oldTokens) && prefixLen < len(newTokens) && oldTokens[prefixLen] == newTokens[prefixLen] {
+		prefixLen++
+	}
+	suffixLen := 0
+	for suffixLen < len(oldTokens)-prefixLen && suffixLen < len(newTokens)-prefixLen &&
+		oldTokens[len(oldTokens)-1-suffixLen] == newTokens[len(newTokens)-1-suffixLen] {
+		suffixLen++
+	}
+
+	oldChanged := make([]bool, len(oldTokens))
+	newChanged := make([]bool, len(newTokens))
+	oldMiddle := oldTokens[prefixLen : len(oldTokens)-suffixLen]
+	newMiddle := newTokens[prefixLen : len(newTokens)-suffixLen]
+	oldMiddleChanged, newMiddleChanged := changedTokens(oldMiddle, newMiddle)
+	copy(oldChanged[prefixLen:], oldMiddleChanged)
+	copy(newChanged[prefixLen:], newMiddleChanged)
+
+	hasCommonContent := lo.SomeBy(lo.Range(len(oldTokens)), func(i int) bool {
+		return !oldChanged[i] && strings.TrimSpace(oldTokens[i]) != ""
+	})
+	if !hasCommonContent {
+		return nil, nil, false
+	}
+
+	return toRanges(oldTokens, oldChanged), toRanges(newTokens, newChanged), true
+}
+
+// Marks the tokens that aren't part of the longest common subsequence of the
+// two lists
+func changedTokens(oldTokens []string, newTokens []string) ([]bool, []bool) {
+	oldChanged := make([]bool, len(oldTokens))
+	newChanged := make([]bool, len(newTokens))
+	for i := range oldChanged {
+		oldChanged[i] = true
+	}
+	for i := range newChanged {
+		newChanged[i] = true
+	}
+
+	if len(oldTokens) == 0 || len(newTokens) == 0 || len(oldTokens)*len(newTokens) > maxInlineDiffComparisons {
+		return oldChanged, newChanged
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of
+	// oldTokens[i:] and newTokens[j:]
+	lengths := make([][]int, len(oldTokens)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(newTokens)+1)
+	}
+	for i := len(oldTokens) - 1; i >= 0; i-- {
+		for j := len(newTokens) - 1; j >= 0; j-- {
+			if oldTokens[i] == newTokens[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = utils.Max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	for i, j := 0, 0; i < len(oldTokens) && j < len(newTokens); {
+		switch {
+		case oldTokens[i] == newTokens[j]:
+			oldChanged[i] = false
+			newChanged[j] = false
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return oldChanged, newChanged
+}
+
+// Splits a line into characters, or into words, runs of whitespace and
+// individual punctuation characters
+func tokenize(line string, mode InlineDiffMode) []string {
+	if mode == InlineDiffChar {
+		return lo.Map([]rune(line), func(r rune, _ int) string { return string(r) })
+	}
+
+	tokens := []string{}
+	for len(line) > 0 {
+		r, size := utf8.DecodeRuneInString(line)
+		if isWordRune(r) || unicode.IsSpace(r) {
+			sameClass := isWordRune
+			if unicode.IsSpace(r) {
+				sameClass = unicode.IsSpace
+			}
+			for size < len(line) {
+				next, nextSize := utf8.DecodeRuneInString(line[size:])
+				if !sameClass(next) {
+					break
+				}
+				size += nextSize
+			}
+		}
+
+		tokens = append(tokens, line[:size])
+		line = line[size:]
+	}
+
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Turns the changed tokens into byte ranges, merging adjacent ones
+func toRanges(tokens []string, changed []bool) []inlineRange {
+	ranges := []inlineRange{}
+	offset := 0
+	for i, token := range tokens {
+		if changed[i] {
+			if len(ranges) > 0 && ranges[len(ranges)-1].end == offset {
+				ranges[len(ranges)-1].end += len(token)
+			} else {
+				ranges = append(ranges, inlineRange{start: offset, end: offset + len(token)})
+			}
+		}
+		offset += len(token)
+	}
+
+	return ranges
+}
@@ -0,0 +1,98 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineDiffRanges(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		lines    []string
+		mode     InlineDiffMode
+		expected map[int][]inlineRange
+	}{
+		{
+			testName: "disabled",
+			lines:    []string{"-foo bar", "+foo baz"},
+			mode:     InlineDiffNone,
+			expected: map[int][]inlineRange{},
+		},
+		{
+			testName: "changed word",
+			lines:    []string{" context", "-foo bar qux", "+foo baz qux"},
+			mode:     InlineDiffWord,
+			expected: map[int][]inlineRange{
+				1: {{start: 4, end: 7}},
+				2: {{start: 4, end: 7}},
+			},
+		},
+		{
+			testName: "changed character",
+			lines:    []string{"-recieve(x)", "+receive(x)"},
+			mode:     InlineDiffChar,
+			expected: map[int][]inlineRange{
+				0: {{start: 3, end: 4}},
+				1: {{start: 4, end: 5}},
+			},
+		},
+		{
+			testName: "inserted and removed words are only highlighted on their side",
+			lines:    []string{"-a(b, c)", "+a(b, d, c)"},
+			mode:     InlineDiffWord,
+			expected: map[int][]inlineRange{
+				0: {},
+				1: {{start: 5, end: 8}},
+			},
+		},
+		{
+			testName: "lines are paired up in order, and leftover lines are left alone",
+			lines:    []string{"-one 1", "-two 2", "-three 3", "+one 10", "+two 20", " context", "+four 4"},
+			mode:     InlineDiffWord,
+			expected: map[int][]inlineRange{
+				0: {{start: 4, end: 5}},
+				1: {{start: 4, end: 5}},
+				3: {{start: 4, end: 6}},
+				4: {{start: 4, end: 6}},
+			},
+		},
+		{
+			testName: "lines with nothing but whitespace in common are not highlighted",
+			lines:    []string{"-foo bar", "+baz qux"},
+			mode:     InlineDiffWord,
+			expected: map[int][]inlineRange{},
+		},
+		{
+			testName: "multibyte characters",
+			lines:    []string{"-héllo wörld", "+héllo world"},
+			mode:     InlineDiffChar,
+			expected: map[int][]inlineRange{
+				0: {{start: 8, end: 10}},
+				1: {{start: 8, end: 9}},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			lines := make([]*PatchLine, 0, len(s.lines))
+			for _, line := range s.lines {
+				lines = append(lines, newHunkLine(line))
+			}
+
+			assert.Equal(t, s.expected, inlineDiffRanges(lines, s.mode))
+		})
+	}
+}
+
+func TestFormatViewWithInlineDiffKeepsLines(t *testing.T) {
+	diff := "diff --git a/file b/file\n--- a/file\n+++ b/file\n@@ -1,2 +1,2 @@\n-foo bar\n+foo baz\n context\n"
+
+	plain := Parse(diff).FormatPlain()
+	formatted := Parse(diff).FormatView(FormatViewOpts{InlineDiffMode: InlineDiffWord})
+
+	assert.Equal(t, plain, utils.Decolorise(formatted))
+}
@@ -81,15 +81,35 @@ func (self *Patch) ContainsChanges() bool {
 // If the line is a hunk header line, returns the first file line number in that hunk.
 // If the line is out of range below, returns the last file line number in the last hunk.
 func (self *Patch) LineNumberOfLine(idx int) int {
+	return self.lineNumberOfLine(idx, false)
+}
+
+// Same as LineNumberOfLine, but returns the line number in the old file. Added
+// lines don't exist in the old file, so they are mapped to the old line that
+// follows them.
+func (self *Patch) OldLineNumberOfLine(idx int) int {
+	return self.lineNumberOfLine(idx, true)
+}
+
+func (self *Patch) lineNumberOfLine(idx int, oldFile bool) int {
 	if idx < len(self.header) || len(self.hunks) == 0 {
 		return 1
 	}
 
+	start := func(hunk *Hunk) int { return hunk.newStart }
+	length := (*Hunk).newLength
+	kinds := []PatchLineKind{ADDITION, CONTEXT}
+	if oldFile {
+		start = func(hunk *Hunk) int { return hunk.oldStart }
+		length = (*Hunk).oldLength
+		kinds = []PatchLineKind{DELETION, CONTEXT}
+	}
+
 	hunkIdx := self.HunkContainingLine(idx)
 	// cursor out of range, return last file line number
 	if hunkIdx == -1 {
 		lastHunk := self.hunks[len(self.hunks)-1]
-		return lastHunk.newStart + lastHunk.newLength() - 1
+		return start(lastHunk) + length(lastHunk) - 1
 	}
 
 	hunk := self.hunks[hunkIdx]
@@ -97,12 +117,12 @@ func (self *Patch) LineNumberOfLine(idx int) int {
 	idxInHunk := idx - hunkStartIdx
 
 	if idxInHunk == 0 {
-		return hunk.newStart
+		return start(hunk)
 	}
 
 	lines := hunk.bodyLines[:idxInHunk-1]
-	offset := nLinesWithKind(lines, []PatchLineKind{ADDITION, CONTEXT})
-	return hunk.newStart + offset
+	offset := nLinesWithKind(lines, kinds)
+	return start(hunk) + offset
 }
 
 // Returns hunk index containing the line at the given patch line index
@@ -154,3 +174,41 @@ func (self *Patch) LineCount() int {
 func (self *Patch) HunkCount() int {
 	return len(self.hunks)
 }
+
+// An inclusive range of patch line indices
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Returns the pieces that the given hunk can be split into, the way `git add
+// -p` splits hunks: one piece per run of changes, with the unchanged lines in
+// between shared out between the pieces on either side. The first piece
+// includes the hunk header. A hunk with a single run of changes comes back as
+// one piece covering the whole hunk.
+func (self *Patch) HunkPieces(hunkIndex int) []LineRange {
+	hunkIndex = utils.Clamp(hunkIndex, 0, len(self.hunks)-1)
+	hunkStartIdx := self.HunkStartIdx(hunkIndex)
+	bodyStartIdx := hunkStartIdx + 1
+
+	pieces := []LineRange{{Start: hunkStartIdx, End: self.HunkEndIdx(hunkIndex)}}
+	lastChangeIdx := -1
+	for i, line := range self.hunks[hunkIndex].bodyLines {
+		if line.Kind == CONTEXT {
+			continue
+		}
+
+		idx := bodyStartIdx + i
+		if lastChangeIdx != -1 && idx > lastChangeIdx+1 {
+			// the previous piece gets the first half of the unchanged lines
+			contextCount := idx - lastChangeIdx - 1
+			boundary := lastChangeIdx + 1 + (contextCount+1)/2
+			hunkEndIdx := pieces[len(pieces)-1].End
+			pieces[len(pieces)-1].End = boundary - 1
+			pieces = append(pieces, LineRange{Start: boundary, End: hunkEndIdx})
+		}
+		lastChangeIdx = idx
+	}
+
+	return pieces
+}
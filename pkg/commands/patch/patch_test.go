@@ -615,6 +615,35 @@ func TestLineNumberOfLine(t *testing.T) {
 	}
 }
 
+func TestOldLineNumberOfLine(t *testing.T) {
+	type scenario struct {
+		testName  string
+		patchStr  string
+		indexes   []int
+		expecteds []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "twoHunks",
+			patchStr:  twoHunks,
+			indexes:   []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 1000},
+			expecteds: []int{1, 1, 1, 1, 1, 1, 2, 3, 3, 4, 5, 8, 8, 9, 10, 11, 11, 11, 12, 13, 13, 13, 13, 13, 13},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			for i, idx := range s.indexes {
+				patch := Parse(s.patchStr)
+				result := patch.OldLineNumberOfLine(idx)
+				assert.Equal(t, s.expecteds[i], result)
+			}
+		})
+	}
+}
+
 func TestGetNextStageableLineIndex(t *testing.T) {
 	type scenario struct {
 		testName  string
@@ -643,3 +672,61 @@ func TestGetNextStageableLineIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestHunkPieces(t *testing.T) {
+	type scenario struct {
+		testName  string
+		patchStr  string
+		hunkIndex int
+		expected  []LineRange
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "single change",
+			patchStr:  simpleDiff,
+			hunkIndex: 0,
+			expected:  []LineRange{{Start: 4, End: 10}},
+		},
+		{
+			testName:  "two changes separated by one line",
+			patchStr:  twoChangesInOneHunk,
+			hunkIndex: 0,
+			expected:  []LineRange{{Start: 4, End: 8}, {Start: 9, End: 11}},
+		},
+		{
+			testName:  "second hunk of a patch",
+			patchStr:  twoHunks,
+			hunkIndex: 1,
+			expected:  []LineRange{{Start: 11, End: 19}},
+		},
+		{
+			testName: "unchanged lines are shared out",
+			patchStr: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,8 +1,8 @@
+-apple
++kiwi
+ orange
+ grape
+ lemon
+ lime
+-pear
++banana
+-plum
+\ No newline at end of file
+`,
+			hunkIndex: 0,
+			expected:  []LineRange{{Start: 4, End: 8}, {Start: 9, End: 14}},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, Parse(s.patchStr).HunkPieces(s.hunkIndex))
+		})
+	}
+}
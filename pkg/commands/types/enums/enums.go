@@ -11,4 +11,6 @@ const (
 	// REBASE_MODE_REBASING is a general state that captures both REBASE_MODE_NORMAL and REBASE_MODE_INTERACTIVE
 	REBASE_MODE_REBASING
 	REBASE_MODE_MERGING
+	// this means we're in the middle of applying patches with `git am`
+	REBASE_MODE_APPLYING
 )
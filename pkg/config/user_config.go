@@ -125,6 +125,9 @@ type GuiConfig struct {
 	ShowFileIcons bool `yaml:"showFileIcons"`
 	// If true, show commit hashes alongside branch names in the branches view.
 	ShowBranchCommitHash bool `yaml:"showBranchCommitHash"`
+	// If true, show the names of the branches of a branch stack next to their
+	// markers in the commits view, so that you can see where each branch ends.
+	ShowBranchStackNames bool `yaml:"showBranchStackNames"`
 	// Height of the command log view
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
 	// Whether to split the main window when viewing file changes.
@@ -207,6 +210,12 @@ type GitConfig struct {
 	ParseEmoji bool `yaml:"parseEmoji"`
 	// Config for showing the log in the commits view
 	Log LogConfig `yaml:"log"`
+	// Notes refs to load and show commit notes from, in addition to the default one (refs/notes/commits), e.g. 'refs/notes/review'
+	NotesRefs []string `yaml:"notesRefs" jsonschema:"uniqueItems=true"`
+	// Warn before committing a file larger than this many bytes that isn't tracked by Git LFS. Set to 0 to disable the warning.
+	LargeFileWarningSize int64 `yaml:"largeFileWarningSize" jsonschema:"minimum=0"`
+	// In the staging view and the custom patch view, highlight the words ('word') or characters ('char') that changed within each pair of removed and added lines, rather than just colouring whole lines ('none')
+	InlineDiffHighlight string `yaml:"inlineDiffHighlight" jsonschema:"enum=none,enum=word,enum=char"`
 }
 
 type PagerType string
@@ -236,6 +245,48 @@ type PagingConfig struct {
 type CommitConfig struct {
 	// If true, pass '--signoff' flag when committing
 	SignOff bool `yaml:"signOff"`
+	// Trailers to offer in the commit message panel's trailers menu, on top of Signed-off-by, Co-authored-by and Reviewed-by
+	Trailers []string `yaml:"trailers" jsonschema:"example=Acked-by,example=Fixes"`
+	// If true, ask for the type and scope of a conventional commit (https://www.conventionalcommits.org) before writing the commit message, and check that the summary follows the format before committing
+	ConventionalCommits bool `yaml:"conventionalCommits"`
+	// The types to choose from when conventionalCommits is enabled
+	ConventionalCommitTypes []string `yaml:"conventionalCommitTypes"`
+	// Rules that commit messages are checked against. Violations are shown
+	// below the commit message panel while typing, and rules set to 'block'
+	// prevent committing or rewording until they are fixed.
+	Lint CommitLintConfig `yaml:"lint"`
+}
+
+type CommitLintConfig struct {
+	// Limits the length of the summary line
+	SummaryLength CommitLintLengthRule `yaml:"summaryLength"`
+	// Limits the length of each line of the description
+	BodyLineLength CommitLintLengthRule `yaml:"bodyLineLength"`
+	// Disallows ending the summary line with a period
+	SummaryTrailingPeriod CommitLintRule `yaml:"summaryTrailingPeriod"`
+	// Requires the message to reference a ticket, matching the given pattern
+	TicketReference CommitLintPatternRule `yaml:"ticketReference"`
+	// Requires the summary to be a single line, separated from the description by a blank line
+	BlankLineAfterSummary CommitLintRule `yaml:"blankLineAfterSummary"`
+}
+
+type CommitLintRule struct {
+	// One of 'off' | 'warn' | 'block'
+	Level string `yaml:"level" jsonschema:"enum=off,enum=warn,enum=block"`
+}
+
+type CommitLintLengthRule struct {
+	// One of 'off' | 'warn' | 'block'
+	Level string `yaml:"level" jsonschema:"enum=off,enum=warn,enum=block"`
+	// The maximum number of characters
+	Max int `yaml:"max" jsonschema:"minimum=1"`
+}
+
+type CommitLintPatternRule struct {
+	// One of 'off' | 'warn' | 'block'
+	Level string `yaml:"level" jsonschema:"enum=off,enum=warn,enum=block"`
+	// Regular expression that must match somewhere in the commit message
+	Pattern string `yaml:"pattern" jsonschema:"example=[A-Z]+-\\d+,example=#\\d+"`
 }
 
 type MergingConfig struct {
@@ -244,6 +295,12 @@ type MergingConfig struct {
 	ManualCommit bool `yaml:"manualCommit"`
 	// Extra args passed to `git merge`, e.g. --no-ff
 	Args string `yaml:"args" jsonschema:"example=--no-ff"`
+	// If true, merges and rebases started from the branches panel are simulated
+	// first, to warn about any conflicts they would run into. Simulating a rebase
+	// takes longer the more commits there are to rebase, so it is skipped for
+	// branches with more than 50 commits.
+	// Requires git 2.38 or later.
+	WarnAboutConflicts bool `yaml:"warnAboutConflicts"`
 }
 
 type LogConfig struct {
@@ -256,6 +313,10 @@ type LogConfig struct {
 	ShowGraph string `yaml:"showGraph" jsonschema:"enum=always,enum=never,enum=when-maximised"`
 	// displays the whole git graph by default in the commits view (equivalent to passing the `--all` argument to `git log`)
 	ShowWholeGraph bool `yaml:"showWholeGraph"`
+	// If true, verify the signatures of the commits in the commits view and show
+	// whether they are good, bad, of unknown validity, or missing. Verifying
+	// signatures can be slow, so this happens after the commits are shown.
+	ShowSignatureStatus bool `yaml:"showSignatureStatus"`
 }
 
 type CommitPrefixConfig struct {
@@ -273,17 +334,19 @@ type UpdateConfig struct {
 }
 
 type KeybindingConfig struct {
-	Universal     KeybindingUniversalConfig     `yaml:"universal"`
-	Status        KeybindingStatusConfig        `yaml:"status"`
-	Files         KeybindingFilesConfig         `yaml:"files"`
-	Branches      KeybindingBranchesConfig      `yaml:"branches"`
-	Worktrees     KeybindingWorktreesConfig     `yaml:"worktrees"`
-	Commits       KeybindingCommitsConfig       `yaml:"commits"`
-	Stash         KeybindingStashConfig         `yaml:"stash"`
-	CommitFiles   KeybindingCommitFilesConfig   `yaml:"commitFiles"`
-	Main          KeybindingMainConfig          `yaml:"main"`
-	Submodules    KeybindingSubmodulesConfig    `yaml:"submodules"`
-	CommitMessage KeybindingCommitMessageConfig `yaml:"commitMessage"`
+	Universal      KeybindingUniversalConfig      `yaml:"universal"`
+	Status         KeybindingStatusConfig         `yaml:"status"`
+	Files          KeybindingFilesConfig          `yaml:"files"`
+	Branches       KeybindingBranchesConfig       `yaml:"branches"`
+	Worktrees      KeybindingWorktreesConfig      `yaml:"worktrees"`
+	Commits        KeybindingCommitsConfig        `yaml:"commits"`
+	Stash          KeybindingStashConfig          `yaml:"stash"`
+	CommitFiles    KeybindingCommitFilesConfig    `yaml:"commitFiles"`
+	Main           KeybindingMainConfig           `yaml:"main"`
+	Submodules     KeybindingSubmodulesConfig     `yaml:"submodules"`
+	SparseCheckout KeybindingSparseCheckoutConfig `yaml:"sparseCheckout"`
+	CommitMessage  KeybindingCommitMessageConfig  `yaml:"commitMessage"`
+	Blame          KeybindingBlameConfig          `yaml:"blame"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -369,6 +432,7 @@ type KeybindingFilesConfig struct {
 	AmendLastCommit          string `yaml:"amendLastCommit"`
 	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
 	FindBaseCommitForFixup   string `yaml:"findBaseCommitForFixup"`
+	Absorb                   string `yaml:"absorb"`
 	ConfirmDiscard           string `yaml:"confirmDiscard"`
 	IgnoreFile               string `yaml:"ignoreFile"`
 	RefreshFiles             string `yaml:"refreshFiles"`
@@ -381,6 +445,11 @@ type KeybindingFilesConfig struct {
 	OpenMergeTool            string `yaml:"openMergeTool"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
+	OpenBlame                string `yaml:"openBlame"`
+	OpenLfsMenu              string `yaml:"openLfsMenu"`
+	OpenRerereMenu           string `yaml:"openRerereMenu"`
+	StageHunksOneByOne       string `yaml:"stageHunksOneByOne"`
+	AddToSparseCheckout      string `yaml:"addToSparseCheckout"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -399,6 +468,8 @@ type KeybindingBranchesConfig struct {
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
 	SortOrder              string `yaml:"sortOrder"`
+	ViewRangeDiffOptions   string `yaml:"viewRangeDiffOptions"`
+	PreviewConflicts       string `yaml:"previewConflicts"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -430,21 +501,34 @@ type KeybindingCommitsConfig struct {
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
+	OpenNotesMenu                  string `yaml:"openNotesMenu"`
+	OpenPatchFilesMenu             string `yaml:"openPatchFilesMenu"`
+	SplitCommit                    string `yaml:"splitCommit"`
+	MoveCommitsToBranch            string `yaml:"moveCommitsToBranch"`
+	OpenBranchStackMenu            string `yaml:"openBranchStackMenu"`
 }
 
 type KeybindingStashConfig struct {
-	PopStash    string `yaml:"popStash"`
-	RenameStash string `yaml:"renameStash"`
+	PopStash        string `yaml:"popStash"`
+	RenameStash     string `yaml:"renameStash"`
+	BranchFromStash string `yaml:"branchFromStash"`
 }
 
 type KeybindingCommitFilesConfig struct {
 	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	CommitSplitPart    string `yaml:"commitSplitPart"`
 }
 
 type KeybindingMainConfig struct {
-	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
-	PickBothHunks    string `yaml:"pickBothHunks"`
-	EditSelectHunk   string `yaml:"editSelectHunk"`
+	ToggleSelectHunk     string `yaml:"toggleSelectHunk"`
+	PickBothHunks        string `yaml:"pickBothHunks"`
+	EditSelectHunk       string `yaml:"editSelectHunk"`
+	ViewLineRangeHistory string `yaml:"viewLineRangeHistory"`
+	StashSelection       string `yaml:"stashSelection"`
+	SplitHunk            string `yaml:"splitHunk"`
+	SkipHunk             string `yaml:"skipHunk"`
+	OpenMergeEditor      string `yaml:"openMergeEditor"`
+	ShowConflictBase     string `yaml:"showConflictBase"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -453,8 +537,18 @@ type KeybindingSubmodulesConfig struct {
 	BulkMenu string `yaml:"bulkMenu"`
 }
 
+type KeybindingSparseCheckoutConfig struct {
+	Reapply string `yaml:"reapply"`
+	Disable string `yaml:"disable"`
+}
+
 type KeybindingCommitMessageConfig struct {
 	SwitchToEditor string `yaml:"switchToEditor"`
+	AddTrailer     string `yaml:"addTrailer"`
+}
+
+type KeybindingBlameConfig struct {
+	BlamePreviousRevision string `yaml:"blamePreviousRevision"`
 }
 
 // OSConfig contains config on the level of the os
@@ -515,6 +609,10 @@ type OSConfig struct {
 	// CopyToClipboardCmd is the command for copying to clipboard.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-clipboard
 	CopyToClipboardCmd string `yaml:"copyToClipboardCmd,omitempty"`
+
+	// ReadFromClipboardCmd is the command for reading the clipboard contents.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-clipboard
+	ReadFromClipboardCmd string `yaml:"readFromClipboardCmd,omitempty"`
 }
 
 type CustomCommandAfterHook struct {
@@ -639,6 +737,7 @@ func GetDefaultConfig() *UserConfig {
 			NerdFontsVersion:          "",
 			ShowFileIcons:             true,
 			ShowBranchCommitHash:      false,
+			ShowBranchStackNames:      false,
 			CommandLogSize:            8,
 			SplitDiff:                 "auto",
 			SkipRewordInEditorWarning: false,
@@ -654,11 +753,22 @@ func GetDefaultConfig() *UserConfig {
 				ExternalDiffCommand: "",
 			},
 			Commit: CommitConfig{
-				SignOff: false,
+				SignOff:                 false,
+				Trailers:                []string{},
+				ConventionalCommits:     false,
+				ConventionalCommitTypes: []string{"feat", "fix", "docs", "style", "refactor", "perf", "test", "build", "ci", "chore", "revert"},
+				Lint: CommitLintConfig{
+					SummaryLength:         CommitLintLengthRule{Level: "off", Max: 50},
+					BodyLineLength:        CommitLintLengthRule{Level: "off", Max: 72},
+					SummaryTrailingPeriod: CommitLintRule{Level: "off"},
+					TicketReference:       CommitLintPatternRule{Level: "off", Pattern: ""},
+					BlankLineAfterSummary: CommitLintRule{Level: "off"},
+				},
 			},
 			Merging: MergingConfig{
-				ManualCommit: false,
-				Args:         "",
+				ManualCommit:       false,
+				Args:               "",
+				WarnAboutConflicts: true,
 			},
 			Log: LogConfig{
 				Order:          "topo-order",
@@ -675,6 +785,9 @@ func GetDefaultConfig() *UserConfig {
 			DisableForcePushing: false,
 			CommitPrefixes:      map[string]CommitPrefixConfig(nil),
 			ParseEmoji:          false,
+			// 50MiB, which is where GitHub starts warning about large files
+			LargeFileWarningSize: 50 * 1024 * 1024,
+			InlineDiffHighlight:  "none",
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
@@ -767,6 +880,7 @@ func GetDefaultConfig() *UserConfig {
 				AmendLastCommit:          "A",
 				CommitChangesWithEditor:  "C",
 				FindBaseCommitForFixup:   "<c-f>",
+				Absorb:                   "B",
 				IgnoreFile:               "i",
 				RefreshFiles:             "r",
 				StashAllChanges:          "s",
@@ -779,6 +893,11 @@ func GetDefaultConfig() *UserConfig {
 				OpenStatusFilter:         "<c-b>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
+				OpenBlame:                "b",
+				OpenLfsMenu:              "<c-l>",
+				OpenRerereMenu:           "<c-x>",
+				StageHunksOneByOne:       "<c-a>",
+				AddToSparseCheckout:      "<c-k>",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -796,6 +915,8 @@ func GetDefaultConfig() *UserConfig {
 				SetUpstream:            "u",
 				FetchRemote:            "f",
 				SortOrder:              "s",
+				ViewRangeDiffOptions:   "D",
+				PreviewConflicts:       "X",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -825,26 +946,47 @@ func GetDefaultConfig() *UserConfig {
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
+				OpenNotesMenu:                  "N",
+				OpenPatchFilesMenu:             "X",
+				SplitCommit:                    "<c-x>",
+				MoveCommitsToBranch:            "M",
+				OpenBranchStackMenu:            "<c-b>",
 			},
 			Stash: KeybindingStashConfig{
-				PopStash:    "g",
-				RenameStash: "r",
+				PopStash:        "g",
+				RenameStash:     "r",
+				BranchFromStash: "b",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
+				CommitSplitPart:    "C",
 			},
 			Main: KeybindingMainConfig{
-				ToggleSelectHunk: "a",
-				PickBothHunks:    "b",
-				EditSelectHunk:   "E",
+				ToggleSelectHunk:     "a",
+				PickBothHunks:        "b",
+				EditSelectHunk:       "E",
+				ViewLineRangeHistory: "<c-l>",
+				StashSelection:       "s",
+				SplitHunk:            "S",
+				SkipHunk:             "n",
+				OpenMergeEditor:      "E",
+				ShowConflictBase:     "B",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
 				Update:   "u",
 				BulkMenu: "b",
 			},
+			SparseCheckout: KeybindingSparseCheckoutConfig{
+				Reapply: "r",
+				Disable: "D",
+			},
 			CommitMessage: KeybindingCommitMessageConfig{
 				SwitchToEditor: "<c-o>",
+				AddTrailer:     "<c-t>",
+			},
+			Blame: KeybindingBlameConfig{
+				BlamePreviousRevision: "b",
 			},
 		},
 		OS:                           OSConfig{},
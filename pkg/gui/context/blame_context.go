@@ -0,0 +1,84 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameContext struct {
+	*BlameViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+	*SearchTrait
+}
+
+var _ types.IListContext = (*BlameContext)(nil)
+
+func NewBlameContext(c *ContextCommon) *BlameContext {
+	viewModel := &BlameViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.BlameLine { return viewModel.lines },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetBlameLineListDisplayStrings(viewModel.lines)
+	}
+
+	ctx := &BlameContext{
+		BlameViewModel:      viewModel,
+		SearchTrait:         NewSearchTrait(c),
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.BlameDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().Blame,
+				WindowName: "main",
+				Key:        BLAME_CONTEXT_KEY,
+				Kind:       types.MAIN_CONTEXT,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+				getColumnAlignments: func() []utils.Alignment {
+					return []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignLeft}
+				},
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
+
+	return ctx
+}
+
+type BlameViewModel struct {
+	*ListViewModel[*models.BlameLine]
+
+	lines []*models.BlameLine
+	// the file being blamed, as it is named in ref
+	filename string
+	// the revision being blamed; empty if we're blaming the working tree
+	ref string
+}
+
+func (self *BlameViewModel) SetBlame(filename string, ref string, lines []*models.BlameLine) {
+	self.filename = filename
+	self.ref = ref
+	self.lines = lines
+}
+
+func (self *BlameViewModel) GetFilename() string {
+	return self.filename
+}
+
+func (self *BlameViewModel) GetRef() string {
+	return self.ref
+}
+
+// There is currently no need to use range-select in the blame view so we're disabling it.
+func (self *BlameContext) RangeSelectEnabled() bool {
+	return false
+}
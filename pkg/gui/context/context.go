@@ -29,6 +29,14 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	MERGE_EDITOR_BASE_CONTEXT_KEY        types.ContextKey = "mergeEditorBase"
+	MERGE_EDITOR_OURS_CONTEXT_KEY        types.ContextKey = "mergeEditorOurs"
+	MERGE_EDITOR_THEIRS_CONTEXT_KEY      types.ContextKey = "mergeEditorTheirs"
+	MERGE_EDITOR_RESULT_CONTEXT_KEY      types.ContextKey = "mergeEditorResult"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
+	RANGE_DIFF_CONTEXT_KEY               types.ContextKey = "rangeDiff"
+	RERERE_RESOLUTIONS_CONTEXT_KEY       types.ContextKey = "rerereResolutions"
+	SPARSE_CHECKOUT_CONTEXT_KEY          types.ContextKey = "sparseCheckout"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
@@ -56,11 +64,14 @@ var AllContextKeys = []types.ContextKey{
 	LOCAL_BRANCHES_CONTEXT_KEY,
 	REMOTES_CONTEXT_KEY,
 	WORKTREES_CONTEXT_KEY,
+	SPARSE_CHECKOUT_CONTEXT_KEY,
 	REMOTE_BRANCHES_CONTEXT_KEY,
 	TAGS_CONTEXT_KEY,
 	LOCAL_COMMITS_CONTEXT_KEY,
 	REFLOG_COMMITS_CONTEXT_KEY,
 	SUB_COMMITS_CONTEXT_KEY,
+	RANGE_DIFF_CONTEXT_KEY,
+	RERERE_RESOLUTIONS_CONTEXT_KEY,
 	COMMIT_FILES_CONTEXT_KEY,
 	STASH_CONTEXT_KEY,
 	NORMAL_MAIN_CONTEXT_KEY,
@@ -70,6 +81,11 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	MERGE_EDITOR_BASE_CONTEXT_KEY,
+	MERGE_EDITOR_OURS_CONTEXT_KEY,
+	MERGE_EDITOR_THEIRS_CONTEXT_KEY,
+	MERGE_EDITOR_RESULT_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -93,9 +109,12 @@ type ContextTree struct {
 	Remotes                     *RemotesContext
 	Worktrees                   *WorktreesContext
 	Submodules                  *SubmodulesContext
+	SparseCheckout              *SparseCheckoutContext
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
 	SubCommits                  *SubCommitsContext
+	RangeDiff                   *RangeDiffContext
+	RerereResolutions           *RerereResolutionsContext
 	Stash                       *StashContext
 	Suggestions                 *SuggestionsContext
 	Normal                      types.Context
@@ -105,6 +124,11 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	MergeEditorBase             *MergeEditorPaneContext
+	MergeEditorOurs             *MergeEditorPaneContext
+	MergeEditorTheirs           *MergeEditorPaneContext
+	MergeEditorResult           *MergeEditorResultContext
+	Blame                       *BlameContext
 	Confirmation                *ConfirmationContext
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
@@ -129,8 +153,11 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Snake,
 		self.Submodules,
 		self.Worktrees,
+		self.SparseCheckout,
+		self.RerereResolutions,
 		self.Files,
 		self.SubCommits,
+		self.RangeDiff,
 		self.Remotes,
 		self.RemoteBranches,
 		self.Tags,
@@ -144,7 +171,12 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.CommitMessage,
 		self.CommitDescription,
 
+		self.Blame,
 		self.MergeConflicts,
+		self.MergeEditorBase,
+		self.MergeEditorOurs,
+		self.MergeEditorTheirs,
+		self.MergeEditorResult,
 		self.StagingSecondary,
 		self.Staging,
 		self.CustomPatchBuilderSecondary,
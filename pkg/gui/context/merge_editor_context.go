@@ -0,0 +1,166 @@
+package context
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// One of the panes of the merge editor showing a version of the conflict that
+// is being resolved (the base, ours, or theirs), from which lines can be
+// picked into the result.
+type MergeEditorPaneContext struct {
+	*MergeEditorPaneViewModel
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*MergeEditorPaneContext)(nil)
+
+func NewMergeEditorPaneContext(
+	view *gocui.View,
+	windowName string,
+	key types.ContextKey,
+	c *ContextCommon,
+) *MergeEditorPaneContext {
+	viewModel := &MergeEditorPaneViewModel{picked: map[int]bool{}}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*MergeEditorLine { return viewModel.lines },
+	)
+
+	getDisplayStrings := func(startIdx int, endIdx int) [][]string {
+		return lo.Map(viewModel.lines[startIdx:endIdx], func(line *MergeEditorLine, _ int) []string {
+			if viewModel.picked[line.Index] {
+				return []string{style.FgGreen.Sprint("✓"), line.Content}
+			}
+			return []string{" ", line.Content}
+		})
+	}
+
+	getNonModelItems := func() []*NonModelItem {
+		if viewModel.placeholder == "" {
+			return nil
+		}
+		return []*NonModelItem{{Index: 0, Content: viewModel.placeholder}}
+	}
+
+	return &MergeEditorPaneContext{
+		MergeEditorPaneViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:             view,
+				WindowName:       windowName,
+				Key:              key,
+				Kind:             types.MAIN_CONTEXT,
+				Focusable:        true,
+				HighlightOnFocus: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+				getNonModelItems:  getNonModelItems,
+			},
+			c: c,
+		},
+	}
+}
+
+type MergeEditorLine struct {
+	Index   int
+	Content string
+}
+
+func (self *MergeEditorLine) ID() string {
+	return strconv.Itoa(self.Index)
+}
+
+type MergeEditorPaneViewModel struct {
+	*ListViewModel[*MergeEditorLine]
+
+	lines []*MergeEditorLine
+	// indices of the lines that have been picked into the result
+	picked map[int]bool
+	// shown instead of the lines when this version of the conflict isn't
+	// available
+	placeholder string
+}
+
+func (self *MergeEditorPaneViewModel) SetLines(lines []string, placeholder string) {
+	self.lines = lo.Map(lines, func(line string, i int) *MergeEditorLine {
+		return &MergeEditorLine{Index: i, Content: line}
+	})
+	self.placeholder = placeholder
+	self.picked = map[int]bool{}
+}
+
+func (self *MergeEditorPaneViewModel) MarkPicked(lines []*MergeEditorLine) {
+	for _, line := range lines {
+		self.picked[line.Index] = true
+	}
+}
+
+// The pane of the merge editor where the resolution of the conflict is put
+// together, either by picking lines from the other panes or by typing.
+type MergeEditorResultContext struct {
+	types.Context
+	c *ContextCommon
+
+	// the index of the conflict being resolved, among the conflicts of the
+	// file in the merge conflicts context
+	conflictIndex int
+}
+
+func NewMergeEditorResultContext(c *ContextCommon) *MergeEditorResultContext {
+	return &MergeEditorResultContext{
+		Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+			View:             c.Views().MergeEditorResult,
+			WindowName:       "mergeEditorResult",
+			Key:              MERGE_EDITOR_RESULT_CONTEXT_KEY,
+			Kind:             types.MAIN_CONTEXT,
+			Focusable:        true,
+			HighlightOnFocus: true,
+		})),
+		c: c,
+	}
+}
+
+func (self *MergeEditorResultContext) GetConflictIndex() int {
+	return self.conflictIndex
+}
+
+func (self *MergeEditorResultContext) SetConflictIndex(index int) {
+	self.conflictIndex = index
+}
+
+// Each line of the result ends with a newline, except perhaps the last one if
+// the user typed it
+func (self *MergeEditorResultContext) GetLines() []string {
+	lines := strings.Split(self.GetView().TextArea.GetContent(), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+func (self *MergeEditorResultContext) SetContent(content string) {
+	view := self.GetView()
+	view.ClearTextArea()
+	view.TextArea.TypeString(content)
+	view.RenderTextArea()
+}
+
+func (self *MergeEditorResultContext) AppendLines(lines []string) {
+	content := self.GetView().TextArea.GetContent()
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	for _, line := range lines {
+		content += line + "\n"
+	}
+
+	self.SetContent(content)
+}
@@ -2,6 +2,7 @@ package context
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	deadlock "github.com/sasha-s/go-deadlock"
@@ -126,7 +127,11 @@ func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices())
+	return self.GetState().RenderForLineIndices(
+		isFocused,
+		self.GetIncludedLineIndices(),
+		patch.InlineDiffMode(self.c.UserConfig.Git.InlineDiffHighlight),
+	)
 }
 
 func (self *PatchExplorerContext) NavigateTo(isFocused bool, selectedLineIdx int) error {
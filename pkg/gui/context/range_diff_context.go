@@ -0,0 +1,75 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffContext struct {
+	*RangeDiffViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+}
+
+var _ types.IListContext = (*RangeDiffContext)(nil)
+
+func NewRangeDiffContext(c *ContextCommon) *RangeDiffContext {
+	viewModel := &RangeDiffViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.RangeDiffPair { return viewModel.pairs },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetRangeDiffPairListDisplayStrings(viewModel.pairs)
+	}
+
+	return &RangeDiffContext{
+		RangeDiffViewModel:  viewModel,
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.RangeDiffDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().RangeDiff,
+				WindowName: "branches",
+				Key:        RANGE_DIFF_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+				Transient:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
+
+type RangeDiffViewModel struct {
+	*ListViewModel[*models.RangeDiffPair]
+
+	pairs []*models.RangeDiffPair
+	// the version of the branch we're comparing against, e.g. its upstream
+	oldRef string
+	// the version of the branch we're looking at, typically the branch itself
+	newRef string
+}
+
+func (self *RangeDiffViewModel) SetRangeDiff(oldRef string, newRef string, pairs []*models.RangeDiffPair) {
+	self.oldRef = oldRef
+	self.newRef = newRef
+	self.pairs = pairs
+}
+
+func (self *RangeDiffViewModel) GetOldRef() string {
+	return self.oldRef
+}
+
+func (self *RangeDiffViewModel) GetNewRef() string {
+	return self.newRef
+}
+
+// There is currently no need to use range-select in the range-diff view so we're disabling it.
+func (self *RangeDiffContext) RangeSelectEnabled() bool {
+	return false
+}
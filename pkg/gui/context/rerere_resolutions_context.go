@@ -0,0 +1,59 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RerereResolutionsContext struct {
+	*RerereResolutionsViewModel
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*RerereResolutionsContext)(nil)
+
+func NewRerereResolutionsContext(c *ContextCommon) *RerereResolutionsContext {
+	viewModel := &RerereResolutionsViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.RerereResolution { return viewModel.resolutions },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetRerereResolutionListDisplayStrings(viewModel.resolutions, c.Tr)
+	}
+
+	return &RerereResolutionsContext{
+		RerereResolutionsViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().RerereResolutions,
+				WindowName: "files",
+				Key:        RERERE_RESOLUTIONS_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+				Transient:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
+
+type RerereResolutionsViewModel struct {
+	*ListViewModel[*models.RerereResolution]
+
+	resolutions []*models.RerereResolution
+}
+
+func (self *RerereResolutionsViewModel) SetResolutions(resolutions []*models.RerereResolution) {
+	self.resolutions = resolutions
+}
+
+// There is currently no need to use range-select in the rerere resolutions view so we're disabling it.
+func (self *RerereResolutionsContext) RangeSelectEnabled() bool {
+	return false
+}
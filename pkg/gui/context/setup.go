@@ -25,20 +25,23 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
-		Files:          NewWorkingTreeContext(c),
-		Submodules:     NewSubmodulesContext(c),
-		Menu:           NewMenuContext(c),
-		Remotes:        NewRemotesContext(c),
-		Worktrees:      NewWorktreesContext(c),
-		RemoteBranches: NewRemoteBranchesContext(c),
-		LocalCommits:   NewLocalCommitsContext(c),
-		CommitFiles:    commitFilesContext,
-		ReflogCommits:  NewReflogCommitsContext(c),
-		SubCommits:     NewSubCommitsContext(c),
-		Branches:       NewBranchesContext(c),
-		Tags:           NewTagsContext(c),
-		Stash:          NewStashContext(c),
-		Suggestions:    NewSuggestionsContext(c),
+		Files:             NewWorkingTreeContext(c),
+		Submodules:        NewSubmodulesContext(c),
+		Menu:              NewMenuContext(c),
+		Remotes:           NewRemotesContext(c),
+		Worktrees:         NewWorktreesContext(c),
+		SparseCheckout:    NewSparseCheckoutContext(c),
+		RemoteBranches:    NewRemoteBranchesContext(c),
+		LocalCommits:      NewLocalCommitsContext(c),
+		CommitFiles:       commitFilesContext,
+		ReflogCommits:     NewReflogCommitsContext(c),
+		SubCommits:        NewSubCommitsContext(c),
+		RangeDiff:         NewRangeDiffContext(c),
+		RerereResolutions: NewRerereResolutionsContext(c),
+		Branches:          NewBranchesContext(c),
+		Tags:              NewTagsContext(c),
+		Stash:             NewStashContext(c),
+		Suggestions:       NewSuggestionsContext(c),
 		Normal: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.MAIN_CONTEXT,
@@ -99,8 +102,28 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		MergeConflicts: NewMergeConflictsContext(
 			c,
 		),
-		Confirmation:  NewConfirmationContext(c),
-		CommitMessage: NewCommitMessageContext(c),
+		MergeEditorBase: NewMergeEditorPaneContext(
+			c.Views().MergeEditorBase,
+			"mergeEditorBase",
+			MERGE_EDITOR_BASE_CONTEXT_KEY,
+			c,
+		),
+		MergeEditorOurs: NewMergeEditorPaneContext(
+			c.Views().MergeEditorOurs,
+			"mergeEditorOurs",
+			MERGE_EDITOR_OURS_CONTEXT_KEY,
+			c,
+		),
+		MergeEditorTheirs: NewMergeEditorPaneContext(
+			c.Views().MergeEditorTheirs,
+			"mergeEditorTheirs",
+			MERGE_EDITOR_THEIRS_CONTEXT_KEY,
+			c,
+		),
+		MergeEditorResult: NewMergeEditorResultContext(c),
+		Blame:             NewBlameContext(c),
+		Confirmation:      NewConfirmationContext(c),
+		CommitMessage:     NewCommitMessageContext(c),
 		CommitDescription: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:                  types.PERSISTENT_POPUP,
@@ -0,0 +1,45 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type SparseCheckoutContext struct {
+	*FilteredListViewModel[*models.SparseCheckoutDirectory]
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*SparseCheckoutContext)(nil)
+
+func NewSparseCheckoutContext(c *ContextCommon) *SparseCheckoutContext {
+	viewModel := NewFilteredListViewModel(
+		func() []*models.SparseCheckoutDirectory { return c.Model().SparseCheckoutDirectories },
+		func(dir *models.SparseCheckoutDirectory) []string {
+			return []string{dir.Path}
+		},
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetSparseCheckoutDirectoryListDisplayStrings(viewModel.GetItems())
+	}
+
+	return &SparseCheckoutContext{
+		FilteredListViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().SparseCheckout,
+				WindowName: "files",
+				Key:        SPARSE_CHECKOUT_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
@@ -145,6 +145,9 @@ type SubCommitsViewModel struct {
 	// name of the ref that the sub-commits are shown for
 	ref                     types.Ref
 	refToShowDivergenceFrom string
+	// if non-empty, we're showing the history of a range of lines of a file
+	// rather than of the whole ref (see GetCommitsOptions.FilterLineRange)
+	filterLineRange string
 	*ListViewModel[*models.Commit]
 
 	limitCommits    bool
@@ -167,6 +170,14 @@ func (self *SubCommitsViewModel) GetRefToShowDivergenceFrom() string {
 	return self.refToShowDivergenceFrom
 }
 
+func (self *SubCommitsViewModel) SetFilterLineRange(lineRange string) {
+	self.filterLineRange = lineRange
+}
+
+func (self *SubCommitsViewModel) GetFilterLineRange() string {
+	return self.filterLineRange
+}
+
 func (self *SubCommitsViewModel) SetShowBranchHeads(value bool) {
 	self.showBranchHeads = value
 }
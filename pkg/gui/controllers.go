@@ -24,7 +24,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
 	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper)
+	conflictPreviewHelper := helpers.NewConflictPreviewHelper(helperCommon, refsHelper)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper, conflictPreviewHelper)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -42,11 +43,15 @@ func (gui *Gui) resetHelpersAndControllers() {
 		setCommitDescription,
 	)
 
+	splitCommitHelper := helpers.NewSplitCommitHelper(helperCommon, commitsHelper, rebaseHelper)
+
 	gpgHelper := helpers.NewGpgHelper(helperCommon)
+	lfsHelper := helpers.NewLfsHelper(helperCommon)
 	viewHelper := helpers.NewViewHelper(helperCommon, gui.State.Contexts)
 	patchBuildingHelper := helpers.NewPatchBuildingHelper(helperCommon)
 	stagingHelper := helpers.NewStagingHelper(helperCommon)
-	mergeConflictsHelper := helpers.NewMergeConflictsHelper(helperCommon)
+	rerereHelper := helpers.NewRerereHelper(helperCommon)
+	mergeConflictsHelper := helpers.NewMergeConflictsHelper(helperCommon, rerereHelper)
 	searchHelper := helpers.NewSearchHelper(helperCommon)
 
 	refreshHelper := helpers.NewRefreshHelper(
@@ -73,6 +78,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		cherryPickHelper,
 		rebaseHelper,
 		bisectHelper,
+		splitCommitHelper,
 	)
 	appStatusHelper := helpers.NewAppStatusHelper(
 		helperCommon,
@@ -94,16 +100,19 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Bisect:          bisectHelper,
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
-		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
+		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, lfsHelper),
 		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper),
 		BranchesHelper:  helpers.NewBranchesHelper(helperCommon),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
+		MergeEditor:     helpers.NewMergeEditorHelper(helperCommon, mergeConflictsHelper),
 		CherryPick:      cherryPickHelper,
 		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
-		FixupHelper:     helpers.NewFixupHelper(helperCommon),
+		FixupHelper:     helpers.NewFixupHelper(helperCommon, rebaseHelper),
+		SplitCommit:     splitCommitHelper,
+		BranchStack:     helpers.NewBranchStackHelper(helperCommon, rebaseHelper),
 		Commits:         commitsHelper,
 		Snake:           helpers.NewSnakeHelper(helperCommon),
 		Diff:            diffHelper,
@@ -123,9 +132,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Search:          searchHelper,
+		Worktree:        worktreeHelper,
+		SubCommits:      helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Blame:           helpers.NewBlameHelper(helperCommon),
+		Lfs:             lfsHelper,
+		RangeDiff:       helpers.NewRangeDiffHelper(helperCommon),
+		ConflictPreview: conflictPreviewHelper,
+		Rerere:          rerereHelper,
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -140,8 +154,11 @@ func (gui *Gui) resetHelpersAndControllers() {
 	)
 
 	submodulesController := controllers.NewSubmodulesController(common)
+	sparseCheckoutController := controllers.NewSparseCheckoutController(common)
 
 	bisectController := controllers.NewBisectController(common)
+	notesController := controllers.NewNotesController(common)
+	patchFilesController := controllers.NewPatchFilesController(common)
 
 	commitMessageController := controllers.NewCommitMessageController(
 		common,
@@ -160,6 +177,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 		common,
 	)
 	mergeConflictsController := controllers.NewMergeConflictsController(common)
+	mergeEditorResultController := controllers.NewMergeEditorResultController(common)
+	blameController := controllers.NewBlameController(common)
 	remotesController := controllers.NewRemotesController(
 		common,
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
@@ -182,6 +201,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	snakeController := controllers.NewSnakeController(common)
 	reflogCommitsController := controllers.NewReflogCommitsController(common)
 	subCommitsController := controllers.NewSubCommitsController(common)
+	rangeDiffController := controllers.NewRangeDiffController(common)
+	rerereResolutionsController := controllers.NewRerereResolutionsController(common)
 	statusController := controllers.NewStatusController(common)
 	commandLogController := controllers.NewCommandLogController(common)
 	confirmationController := controllers.NewConfirmationController(common)
@@ -210,10 +231,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.RemoteBranches,
 		gui.State.Contexts.Files,
 		gui.State.Contexts.Submodules,
+		gui.State.Contexts.SparseCheckout,
 		gui.State.Contexts.ReflogCommits,
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.SubCommits,
+		gui.State.Contexts.RangeDiff,
+		gui.State.Contexts.RerereResolutions,
 		gui.State.Contexts.Stash,
 	} {
 		controllers.AttachControllers(context, sideWindowControllerFactory.Create(context))
@@ -268,6 +292,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		subCommitsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.RangeDiff,
+		rangeDiffController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.RerereResolutions,
+		rerereResolutionsController,
+	)
+
 	// TODO: add scroll controllers for main panels (need to bring some more functionality across for that e.g. reading more from the currently displayed git command)
 	controllers.AttachControllers(gui.State.Contexts.Staging,
 		stagingController,
@@ -295,6 +327,26 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.MergeEditorOurs,
+		controllers.NewMergeEditorPaneController(common, gui.State.Contexts.MergeEditorOurs),
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.MergeEditorBase,
+		controllers.NewMergeEditorPaneController(common, gui.State.Contexts.MergeEditorBase),
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.MergeEditorTheirs,
+		controllers.NewMergeEditorPaneController(common, gui.State.Contexts.MergeEditorTheirs),
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.MergeEditorResult,
+		mergeEditorResultController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		blameController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Files,
 		filesController,
 		filesRemoveController,
@@ -308,9 +360,15 @@ func (gui *Gui) resetHelpersAndControllers() {
 		submodulesController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.SparseCheckout,
+		sparseCheckoutController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.LocalCommits,
 		localCommitsController,
 		bisectController,
+		notesController,
+		patchFilesController,
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.Branches,
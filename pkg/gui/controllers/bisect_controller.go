@@ -2,7 +2,11 @@ package controllers
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -146,6 +150,34 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key:            'S',
 		}))
 	}
+	var runDisabledReason *types.DisabledReason
+	if !info.Bisecting() {
+		runDisabledReason = &types.DisabledReason{
+			Text: fmt.Sprintf(self.c.Tr.Bisect.RunNeedsBothTerms, info.NewTerm(), info.OldTerm()),
+		}
+	}
+	menuItems = append(menuItems,
+		lo.ToPtr(types.MenuItem{
+			Label:   self.c.Tr.Bisect.Run,
+			Tooltip: self.c.Tr.Bisect.RunTooltip,
+			OnPress: func() error {
+				return self.c.Prompt(types.PromptOpts{
+					Title: self.c.Tr.Bisect.RunPrompt,
+					HandleConfirm: func(command string) error {
+						return self.run(command)
+					},
+				})
+			},
+			DisabledReason: runDisabledReason,
+			Key:            'x',
+		}),
+		lo.ToPtr(types.MenuItem{
+			Label:   self.c.Tr.Bisect.SaveLog,
+			Tooltip: self.c.Tr.Bisect.SaveLogTooltip,
+			OnPress: self.saveLog,
+			Key:     'l',
+		}),
+	)
 	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 		Label: self.c.Tr.Bisect.ResetOption,
 		OnPress: func() error {
@@ -220,6 +252,118 @@ func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo,
 				},
 				Key: 't',
 			},
+			{
+				Label:   self.c.Tr.Bisect.ReplayLog,
+				Tooltip: self.c.Tr.Bisect.ReplayLogTooltip,
+				OnPress: self.replayLog,
+				Key:     'l',
+			},
+		},
+	})
+}
+
+func (self *BisectController) run(command string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.Bisect.Running, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.BisectRun)
+
+		stopRefreshing := self.refreshWhileRunning()
+		err := self.c.Git().Bisect.Run(command)
+		stopRefreshing()
+		if err != nil {
+			return err
+		}
+
+		info := self.c.Git().Bisect.GetInfo()
+		done, candidateShas, err := self.c.Git().Bisect.IsDone()
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if err := self.c.Refresh(types.RefreshOptions{
+				Mode:  types.SYNC,
+				Scope: []types.RefreshableView{},
+				Then: func() {
+					if done {
+						self.selectCommit(candidateShas[0])
+					}
+				},
+			}); err != nil {
+				return err
+			}
+
+			if !done {
+				return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.Bisect.RunStopped, info.NewTerm()))
+			}
+
+			return self.showBisectCompleteMessage(candidateShas)
+		})
+
+		return nil
+	})
+}
+
+// While a bisect run is going on, git moves from one commit to the next without
+// us knowing about it, so we keep an eye on the bisect state and refresh the
+// commits whenever it changes so that the user can follow along. Returns a
+// function that stops the refreshing.
+func (self *BisectController) refreshWhileRunning() func() {
+	stop := make(chan struct{})
+
+	go utils.Safe(func() {
+		ticker := time.NewTicker(time.Millisecond * 500)
+		defer ticker.Stop()
+
+		currentSha := self.c.Git().Bisect.GetInfo().GetCurrentSha()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if sha := self.c.Git().Bisect.GetInfo().GetCurrentSha(); sha != currentSha {
+					currentSha = sha
+					_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+				}
+			}
+		}
+	})
+
+	return func() { close(stop) }
+}
+
+func (self *BisectController) saveLog() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.Bisect.SaveLogPrompt,
+		InitialContent:      "bisect.log",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.BisectSaveLog)
+			log, err := self.c.Git().Bisect.GetLog()
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			if err := os.WriteFile(path, []byte(log), 0o644); err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.Bisect.SavedLog, map[string]string{"path": path}))
+			return nil
+		},
+	})
+}
+
+func (self *BisectController) replayLog() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.Bisect.ReplayLogPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.BisectReplayLog)
+			if err := self.c.Git().Bisect.Replay(path); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.afterMark(true, true)
 		},
 	})
 }
@@ -285,13 +429,17 @@ func (self *BisectController) afterBisectMarkRefresh(selectCurrent bool, waitToR
 func (self *BisectController) selectCurrentBisectCommit() {
 	info := self.c.Git().Bisect.GetInfo()
 	if info.GetCurrentSha() != "" {
-		// find index of commit with that sha, move cursor to that.
-		for i, commit := range self.c.Model().Commits {
-			if commit.Sha == info.GetCurrentSha() {
-				self.context().SetSelection(i)
-				_ = self.context().HandleFocus(types.OnFocusOpts{})
-				break
-			}
+		self.selectCommit(info.GetCurrentSha())
+	}
+}
+
+func (self *BisectController) selectCommit(sha string) {
+	// find index of commit with that sha, move cursor to that.
+	for i, commit := range self.c.Model().Commits {
+		if commit.Sha == sha {
+			self.context().SetSelection(i)
+			_ = self.context().HandleFocus(types.OnFocusOpts{})
+			break
 		}
 	}
 }
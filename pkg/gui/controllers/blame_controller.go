@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type BlameController struct {
+	baseController
+	*ListControllerTrait[*models.BlameLine]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	c *ControllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.BlameLine](
+			c,
+			c.Contexts().Blame,
+			c.Contexts().Blame.GetSelected,
+			c.Contexts().Blame.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.goToCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isCommitted)),
+			Description:       self.c.Tr.GoToBlamedCommit,
+			Tooltip:           self.c.Tr.GoToBlamedCommitTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Blame.BlamePreviousRevision),
+			Handler:           self.withItem(self.blamePreviousRevision),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isCommitted)),
+			Description:       self.c.Tr.BlamePreviousRevision,
+			Tooltip:           self.c.Tr.BlamePreviousRevisionTooltip,
+		},
+	}
+}
+
+func (self *BlameController) isCommitted(line *models.BlameLine) *types.DisabledReason {
+	if line.IsNotCommittedYet() {
+		return &types.DisabledReason{Text: self.c.Tr.LineNotCommittedYet}
+	}
+
+	return nil
+}
+
+func (self *BlameController) goToCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.GoToCommit(line)
+}
+
+func (self *BlameController) blamePreviousRevision(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.BlamePreviousRevision(line, self.context().GetSelectedLineIdx())
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
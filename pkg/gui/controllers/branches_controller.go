@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -144,6 +145,22 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Tooltip:           self.c.Tr.ViewBranchUpstreamOptionsTooltip,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewRangeDiffOptions),
+			Handler:           self.withItem(self.viewRangeDiffOptions),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewRangeDiffOptions,
+			Tooltip:           self.c.Tr.ViewRangeDiffOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.PreviewConflicts),
+			Handler:           self.withItem(self.previewConflicts),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canPreviewConflicts)),
+			Description:       self.c.Tr.PreviewConflicts,
+			Tooltip:           self.c.Tr.PreviewConflictsTooltip,
+			OpensMenu:         true,
+		},
 	}
 }
 
@@ -313,6 +330,91 @@ func (self *BranchesController) Context() types.Context {
 	return self.context()
 }
 
+func (self *BranchesController) viewRangeDiffOptions(selectedBranch *models.Branch) error {
+	viewRangeDiff := func(oldRef string) error {
+		return self.c.Helpers().RangeDiff.ViewRangeDiff(oldRef, selectedBranch.RefName(), self.context())
+	}
+
+	upstreamItem := &types.MenuItem{
+		LabelColumns: []string{fmt.Sprintf(self.c.Tr.RangeDiffAgainst, selectedBranch.ShortUpstreamRefName())},
+		OnPress: func() error {
+			return viewRangeDiff(selectedBranch.ShortUpstreamRefName())
+		},
+		Key: 'u',
+	}
+	if !selectedBranch.RemoteBranchStoredLocally() {
+		upstreamItem.LabelColumns = []string{fmt.Sprintf(self.c.Tr.RangeDiffAgainst, self.c.Tr.UpstreamGenericName)}
+		upstreamItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.UpstreamNotSetError}
+	}
+
+	reflogItem := &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.RangeDiffAgainstReflogEntry},
+		OnPress: func() error {
+			return self.viewRangeDiffReflogMenu(selectedBranch, viewRangeDiff)
+		},
+		Key:       'r',
+		OpensMenu: true,
+	}
+
+	// when comparing against some ref in diffing mode, the user will often
+	// want to see how the commits compare, too
+	diffingRefItem := &types.MenuItem{
+		LabelColumns: []string{fmt.Sprintf(self.c.Tr.RangeDiffAgainst, self.c.Modes().Diffing.Ref)},
+		OnPress: func() error {
+			return viewRangeDiff(self.c.Modes().Diffing.Ref)
+		},
+		Key: 'w',
+	}
+	if !self.c.Modes().Diffing.Active() {
+		diffingRefItem.LabelColumns = []string{fmt.Sprintf(self.c.Tr.RangeDiffAgainst, self.c.Tr.DiffingRefGenericName)}
+		diffingRefItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NotInDiffingMode}
+	}
+
+	enterRefItem := &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.RangeDiffAgainstRef},
+		OnPress: func() error {
+			return self.c.Prompt(types.PromptOpts{
+				Title:               self.c.Tr.EnterRefName,
+				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+				HandleConfirm: func(response string) error {
+					return viewRangeDiff(strings.TrimSpace(response))
+				},
+			})
+		},
+		Key: 'e',
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf(self.c.Tr.RangeDiffOptionsTitle, selectedBranch.Name),
+		Items: []*types.MenuItem{upstreamItem, reflogItem, diffingRefItem, enterRefItem},
+	})
+}
+
+func (self *BranchesController) viewRangeDiffReflogMenu(branch *models.Branch, viewRangeDiff func(oldRef string) error) error {
+	entries, err := self.c.Git().RangeDiff.GetBranchReflog(branch.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		return errors.New(self.c.Tr.NoPreviousBranchVersions)
+	}
+
+	menuItems := lo.Map(entries, func(entry *git_commands.BranchReflogEntry, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{entry.Ref, style.FgYellow.Sprint(entry.ShortSha), entry.Subject},
+			OnPress: func() error {
+				return viewRangeDiff(entry.Ref)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RangeDiffAgainstReflogEntry,
+		Items: menuItems,
+	})
+}
+
 func (self *BranchesController) context() *context.BranchesContext {
 	return self.c.Contexts().Branches
 }
@@ -584,6 +686,45 @@ func (self *BranchesController) rebase() error {
 	return self.c.Helpers().MergeAndRebase.RebaseOntoRef(selectedBranchName)
 }
 
+func (self *BranchesController) previewConflicts(selectedBranch *models.Branch) error {
+	placeholders := map[string]string{
+		"selectedBranch":   selectedBranch.Name,
+		"checkedOutBranch": self.c.Helpers().Refs.GetCheckedOutRef().Name,
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PreviewConflicts,
+		Items: []*types.MenuItem{
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.PreviewMerge, placeholders),
+				OnPress: func() error {
+					return self.c.Helpers().ConflictPreview.ShowMergePreview(selectedBranch.Name)
+				},
+				Key: 'm',
+			},
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.PreviewRebase, placeholders),
+				OnPress: func() error {
+					return self.c.Helpers().ConflictPreview.ShowRebasePreview(selectedBranch.Name)
+				},
+				Key: 'r',
+			},
+		},
+	})
+}
+
+func (self *BranchesController) canPreviewConflicts(branch *models.Branch) *types.DisabledReason {
+	if !self.c.Helpers().ConflictPreview.IsSupported() {
+		return &types.DisabledReason{Text: self.c.Tr.ConflictPreviewRequiresNewerGit}
+	}
+
+	if branch.Name == self.c.Helpers().Refs.GetCheckedOutRef().Name {
+		return &types.DisabledReason{Text: self.c.Tr.CantPreviewConflictsWithSelf}
+	}
+
+	return nil
+}
+
 func (self *BranchesController) notRebasingOntoSelf(branch *models.Branch) *types.DisabledReason {
 	selectedBranchName := branch.Name
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef().Name
@@ -39,6 +39,12 @@ func (self *CommitDescriptionController) GetKeybindings(opts types.KeybindingsOp
 			Key:     opts.GetKey(opts.Config.CommitMessage.SwitchToEditor),
 			Handler: self.switchToEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.AddTrailer),
+			Handler:     self.openTrailersMenu,
+			Description: self.c.Tr.AddTrailer,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -67,3 +73,7 @@ func (self *CommitDescriptionController) confirm() error {
 func (self *CommitDescriptionController) switchToEditor() error {
 	return self.c.Helpers().Commits.SwitchToEditor()
 }
+
+func (self *CommitDescriptionController) openTrailersMenu() error {
+	return (&TrailersMenuAction{c: self.c}).Call()
+}
@@ -50,6 +50,12 @@ func (self *CommitMessageController) GetKeybindings(opts types.KeybindingsOpts)
 			Key:     opts.GetKey(opts.Config.CommitMessage.SwitchToEditor),
 			Handler: self.switchToEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.AddTrailer),
+			Handler:     self.openTrailersMenu,
+			Description: self.c.Tr.AddTrailer,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -92,6 +98,10 @@ func (self *CommitMessageController) switchToEditor() error {
 	return self.c.Helpers().Commits.SwitchToEditor()
 }
 
+func (self *CommitMessageController) openTrailersMenu() error {
+	return (&TrailersMenuAction{c: self.c}).Call()
+}
+
 func (self *CommitMessageController) handleCommitIndexChange(value int) error {
 	currentIndex := self.context().GetSelectedIndex()
 	newIndex := currentIndex + value
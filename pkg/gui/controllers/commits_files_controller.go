@@ -65,6 +65,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Select),
 			Handler:           self.withItem(self.toggleForPatch),
@@ -76,6 +83,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.withItem(self.toggleAllForPatch),
 			Description: self.c.Tr.ToggleAllInPatch,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.CommitSplitPart),
+			Handler:           self.c.Helpers().SplitCommit.CommitPart,
+			GetDisabledReason: self.c.Helpers().SplitCommit.CommitPartDisabledReason,
+			Description:       self.c.Tr.CommitSplitPart,
+			Tooltip:           self.c.Tr.CommitSplitPartTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.GoInto),
 			Handler:           self.withItem(self.enter),
@@ -118,8 +132,13 @@ func (self *CommitFilesController) GetOnRenderToMain() func() error {
 		to := ref.RefName()
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
 
-		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
+		var task types.UpdateTask
+		if summary, isLfs := self.lfsDiffSummary(node, from, to, reverse); isLfs {
+			task = types.NewRenderStringTask(summary)
+		} else {
+			cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
+			task = types.NewRunPtyTask(cmdObj.GetCmd())
+		}
 
 		pair := self.c.MainViewPairs().Normal
 		if node.File != nil {
@@ -138,6 +157,14 @@ func (self *CommitFilesController) GetOnRenderToMain() func() error {
 	}
 }
 
+func (self *CommitFilesController) lfsDiffSummary(node *filetree.CommitFileNode, from string, to string, reverse bool) (string, bool) {
+	if node.File == nil {
+		return "", false
+	}
+
+	return self.c.Helpers().Lfs.RefDiffSummary(from, to, reverse, node.GetPath())
+}
+
 func (self *CommitFilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
 	node := self.context().GetSelected()
 	if node == nil {
@@ -206,6 +233,22 @@ func (self *CommitFilesController) edit(node *filetree.CommitFileNode) error {
 	return self.c.Helpers().Files.EditFile(node.GetPath())
 }
 
+func (self *CommitFilesController) canBlame(node *filetree.CommitFileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	if node.File.Deleted() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDeletedFile}
+	}
+
+	return nil
+}
+
+func (self *CommitFilesController) openBlame(node *filetree.CommitFileNode) error {
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), self.context().GetRef().RefName())
+}
+
 func (self *CommitFilesController) openDiffTool(node *filetree.CommitFileNode) error {
 	ref := self.context().GetRef()
 	to := ref.RefName()
@@ -2,11 +2,16 @@ package controllers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type CustomPatchOptionsMenuAction struct {
@@ -14,12 +19,13 @@ type CustomPatchOptionsMenuAction struct {
 }
 
 func (self *CustomPatchOptionsMenuAction) Call() error {
-	if !self.c.Git().Patch.PatchBuilder.Active() {
-		return self.c.ErrorMsg(self.c.Tr.NoPatchError)
-	}
-
-	if self.c.Git().Patch.PatchBuilder.IsEmpty() {
-		return self.c.ErrorMsg(self.c.Tr.EmptyPatchError)
+	if noPatchReason := self.noPatchReason(); noPatchReason != nil {
+		// we can still apply a patch from elsewhere; the items for exporting
+		// the patch are shown disabled to explain how to build one
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: self.c.Tr.PatchOptionsTitle,
+			Items: append(self.exportPatchMenuItems(noPatchReason), self.applyPatchFileMenuItems()...),
+		})
 	}
 
 	menuItems := []*types.MenuItem{
@@ -86,15 +92,56 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 		}
 	}
 
-	menuItems = append(menuItems, []*types.MenuItem{
+	menuItems = append(menuItems, self.exportPatchMenuItems(nil)...)
+	menuItems = append(menuItems, self.applyPatchFileMenuItems()...)
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PatchOptionsTitle, Items: menuItems})
+}
+
+func (self *CustomPatchOptionsMenuAction) noPatchReason() *types.DisabledReason {
+	if !self.c.Git().Patch.PatchBuilder.Active() {
+		return &types.DisabledReason{Text: self.c.Tr.NoPatchError}
+	}
+
+	if self.c.Git().Patch.PatchBuilder.IsEmpty() {
+		return &types.DisabledReason{Text: self.c.Tr.EmptyPatchError}
+	}
+
+	return nil
+}
+
+func (self *CustomPatchOptionsMenuAction) exportPatchMenuItems(disabledReason *types.DisabledReason) []*types.MenuItem {
+	return []*types.MenuItem{
+		{
+			Label:          self.c.Tr.CopyPatchToClipboard,
+			OnPress:        func() error { return self.copyPatchToClipboard() },
+			Key:            'y',
+			DisabledReason: disabledReason,
+		},
 		{
-			Label:   self.c.Tr.CopyPatchToClipboard,
-			OnPress: func() error { return self.copyPatchToClipboard() },
-			Key:     'y',
+			Label:          self.c.Tr.SavePatchToFile,
+			OnPress:        self.savePatchToFile,
+			Key:            's',
+			DisabledReason: disabledReason,
 		},
-	}...)
+	}
+}
 
-	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PatchOptionsTitle, Items: menuItems})
+func (self *CustomPatchOptionsMenuAction) applyPatchFileMenuItems() []*types.MenuItem {
+	return []*types.MenuItem{
+		{
+			Label:   self.c.Tr.ApplyPatchFromFile,
+			Tooltip: self.c.Tr.ApplyPatchFromFileTooltip,
+			OnPress: self.applyPatchFromFile,
+			Key:     'l',
+		},
+		{
+			Label:   self.c.Tr.ApplyPatchFromClipboard,
+			Tooltip: self.c.Tr.ApplyPatchFromClipboardTooltip,
+			OnPress: self.applyPatchFromClipboard,
+			Key:     'v',
+		},
+	}
 }
 
 func (self *CustomPatchOptionsMenuAction) getPatchCommitIndex() int {
@@ -244,3 +291,134 @@ func (self *CustomPatchOptionsMenuAction) copyPatchToClipboard() error {
 
 	return nil
 }
+
+func (self *CustomPatchOptionsMenuAction) savePatchToFile() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.SavePatchPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				return self.c.ErrorMsg(self.c.Tr.PatchFilePathRequired)
+			}
+
+			patch := self.c.Git().Patch.PatchBuilder.RenderAggregatedPatch(true)
+
+			self.c.LogAction(self.c.Tr.Actions.SavePatchToFile)
+			if err := self.c.OS().CreateFileWithContent(path, patch); err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.PatchSaved, map[string]string{"path": path}))
+			return nil
+		},
+	})
+}
+
+func (self *CustomPatchOptionsMenuAction) applyPatchFromFile() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ApplyPatchFilePrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				return self.c.ErrorMsg(self.c.Tr.PatchFilePathRequired)
+			}
+
+			return self.applyPatchFile(path)
+		},
+	})
+}
+
+func (self *CustomPatchOptionsMenuAction) applyPatchFromClipboard() error {
+	content, err := self.c.OS().PasteFromClipboard()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return self.c.ErrorMsg(self.c.Tr.NoPatchInClipboard)
+	}
+
+	// git apply wants a file, and a patch without a trailing newline is corrupt
+	path, err := self.c.Git().Patch.SaveTemporaryPatch(strings.TrimRight(content, "\n") + "\n")
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.applyPatchFile(path)
+}
+
+// Lets the user choose whether to stage the changes too, then checks whether
+// the patch applies cleanly before applying it. If it doesn't, we show the
+// hunks that fail and offer to fall back to a three-way merge.
+func (self *CustomPatchOptionsMenuAction) applyPatchFile(path string) error {
+	apply := func(opts git_commands.ApplyPatchOpts) error {
+		failures, err := self.c.Git().Patch.CheckPatchFile(path, opts)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		if len(failures) == 0 {
+			return self.doApplyPatchFile(path, opts)
+		}
+
+		return self.c.Confirm(types.ConfirmOpts{
+			Title: self.c.Tr.PatchDoesNotApplyTitle,
+			Prompt: utils.ResolvePlaceholderString(self.c.Tr.PatchDoesNotApplyPrompt, map[string]string{
+				"failures": strings.Join(failures, "\n"),
+			}),
+			HandleConfirm: func() error {
+				opts.ThreeWay = true
+				return self.doApplyPatchFile(path, opts)
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ApplyPatchTo,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ApplyPatchToWorkingTree,
+				OnPress: func() error {
+					return apply(git_commands.ApplyPatchOpts{})
+				},
+				Key: 'w',
+			},
+			{
+				Label:   self.c.Tr.ApplyPatchToIndex,
+				Tooltip: self.c.Tr.ApplyPatchToIndexTooltip,
+				OnPress: func() error {
+					return apply(git_commands.ApplyPatchOpts{Index: true})
+				},
+				Key: 'i',
+			},
+		},
+	})
+}
+
+func (self *CustomPatchOptionsMenuAction) doApplyPatchFile(path string, opts git_commands.ApplyPatchOpts) error {
+	if err := self.returnFocusFromPatchExplorerIfNecessary(); err != nil {
+		return err
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatchFile)
+	err := self.c.Git().Patch.ApplyPatchFile(path, opts)
+	// a three-way merge that runs into conflicts fails, but still applies the
+	// rest of the patch and leaves the conflicts for the user to resolve, so
+	// that's not an error as far as we're concerned
+	if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); refreshErr != nil {
+		return refreshErr
+	}
+
+	if err != nil {
+		if opts.ThreeWay && lo.SomeBy(self.c.Model().Files, func(file *models.File) bool { return file.HasMergeConflicts }) {
+			self.c.Toast(self.c.Tr.PatchAppliedWithConflicts)
+			return nil
+		}
+
+		return self.c.Error(err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"path"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -8,7 +9,9 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type FilesController struct {
@@ -78,6 +81,13 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.Absorb),
+			Handler:     self.c.Helpers().FixupHelper.HandleAbsorbPress,
+			Description: self.c.Tr.Absorb,
+			Tooltip:     self.c.Tr.AbsorbTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Edit),
 			Handler:           self.withItem(self.edit),
@@ -147,6 +157,43 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenLfsMenu),
+			Handler:           self.openLfsMenu,
+			GetDisabledReason: self.lfsMenuDisabledReason,
+			Description:       self.c.Tr.OpenLfsMenu,
+			Tooltip:           self.c.Tr.OpenLfsMenuTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenRerereMenu),
+			Handler:           self.openRerereMenu,
+			GetDisabledReason: self.rerereMenuDisabledReason,
+			Description:       self.c.Tr.OpenRerereMenu,
+			Tooltip:           self.c.Tr.OpenRerereMenuTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.StageHunksOneByOne),
+			Handler:           self.c.Helpers().Staging.StartHunkWalkthrough,
+			GetDisabledReason: self.stageHunksOneByOneDisabledReason,
+			Description:       self.c.Tr.StageHunksOneByOne,
+			Tooltip:           self.c.Tr.StageHunksOneByOneTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.AddToSparseCheckout),
+			Handler:           self.withItem(self.addToSparseCheckout),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canAddToSparseCheckout)),
+			Description:       self.c.Tr.AddSparseCheckoutDirectory,
+			Tooltip:           self.c.Tr.AddToSparseCheckoutTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.OpenMergeTool),
 			Handler:     self.c.Helpers().WorkingTree.OpenMergeTool,
@@ -224,6 +271,16 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.File != nil && node.File.HasMergeConflicts && !node.File.HasInlineMergeConflicts {
+				return self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: self.c.Tr.MergeConflictsTitle,
+						Task:  types.NewRenderStringTask((&WholeFileConflictMenuAction{c: self.c}).Explanation(node.File)),
+					},
+				})
+			}
+
 			pair := self.c.MainViewPairs().Normal
 			if node.File != nil {
 				pair = self.c.MainViewPairs().Staging
@@ -232,7 +289,15 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			split := self.c.UserConfig.Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
-			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
+			diffTask := func(staged bool) types.UpdateTask {
+				if node.File != nil && node.File.IsLfs {
+					return types.NewRenderStringTask(self.c.Helpers().Lfs.WorkingTreeDiffSummary(node.File, staged))
+				}
+
+				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, staged)
+				return types.NewRunPtyTask(cmdObj.GetCmd())
+			}
+
 			title := self.c.Tr.UnstagedChanges
 			if mainShowsStaged {
 				title = self.c.Tr.StagedChanges
@@ -240,15 +305,13 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			refreshOpts := types.RefreshMainOpts{
 				Pair: pair,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     diffTask(mainShowsStaged),
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
 			}
 
 			if split {
-				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
-
 				title := self.c.Tr.StagedChanges
 				if mainShowsStaged {
 					title = self.c.Tr.UnstagedChanges
@@ -257,7 +320,7 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 				refreshOpts.Secondary = &types.ViewUpdateOpts{
 					Title:    title,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     diffTask(true),
 				}
 			}
 
@@ -456,6 +519,10 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 
 	file := node.File
 
+	if file.HasMergeConflicts && !file.HasInlineMergeConflicts {
+		return (&WholeFileConflictMenuAction{c: self.c}).Call(file)
+	}
+
 	submoduleConfigs := self.c.Model().Submodules
 	if file.IsSubmodule(submoduleConfigs) {
 		submoduleConfig := file.SubmoduleConfig(submoduleConfigs)
@@ -465,9 +532,6 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 	if file.HasInlineMergeConflicts {
 		return self.switchToMerge()
 	}
-	if file.HasMergeConflicts {
-		return self.c.ErrorMsg(self.c.Tr.FileStagingRequirements)
-	}
 
 	return self.c.PushContext(self.c.Contexts().Staging, opts)
 }
@@ -698,6 +762,214 @@ func (self *FilesController) Open() error {
 	return self.c.Helpers().Files.OpenFile(node.GetPath())
 }
 
+func (self *FilesController) canBlame(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	if !node.File.Tracked || node.File.Added {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameUntrackedFile}
+	}
+
+	if node.File.Deleted {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDeletedFile}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openBlame(node *filetree.FileNode) error {
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), "")
+}
+
+// For a file we add the directory containing it, because cone mode sparse
+// checkouts only deal in directories
+func sparseCheckoutDirOfNode(node *filetree.FileNode) string {
+	if node.File == nil {
+		return node.GetPath()
+	}
+
+	return path.Dir(node.GetPath())
+}
+
+func (self *FilesController) canAddToSparseCheckout(node *filetree.FileNode) *types.DisabledReason {
+	if !self.c.Model().IsSparseCheckout {
+		return &types.DisabledReason{Text: self.c.Tr.NotASparseCheckout}
+	}
+
+	if sparseCheckoutDirOfNode(node) == "." {
+		return &types.DisabledReason{Text: self.c.Tr.RootAlwaysInSparseCheckout}
+	}
+
+	return nil
+}
+
+func (self *FilesController) addToSparseCheckout(node *filetree.FileNode) error {
+	return self.c.WithWaitingStatus(self.c.Tr.AddSparseCheckoutDirectory, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.AddSparseCheckoutDirectory)
+		if err := self.c.Git().SparseCheckout.Add([]string{sparseCheckoutDirOfNode(node)}); err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+	})
+}
+
+func (self *FilesController) lfsMenuDisabledReason() *types.DisabledReason {
+	if !self.c.Git().Lfs.RepoUsesLfs() {
+		return &types.DisabledReason{Text: self.c.Tr.LfsNotUsedInRepo}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openLfsMenu() error {
+	var noFileDisabledReason *types.DisabledReason
+	node := self.context().GetSelected()
+	if node == nil || node.File == nil {
+		noFileDisabledReason = &types.DisabledReason{Text: self.c.Tr.LfsNoFileSelected}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LfsLockFile,
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LfsLockingStatus, func(gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.LfsLockFile)
+						return self.c.Git().Lfs.Lock(node.GetPath())
+					})
+				},
+				DisabledReason: noFileDisabledReason,
+				Key:            'l',
+			},
+			{
+				Label: self.c.Tr.LfsUnlockFile,
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LfsUnlockingStatus, func(gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+						return self.c.Git().Lfs.Unlock(node.GetPath())
+					})
+				},
+				DisabledReason: noFileDisabledReason,
+				Key:            'u',
+			},
+			{
+				Label:     self.c.Tr.LfsViewLocks,
+				OnPress:   self.openLfsLocksMenu,
+				Key:       'v',
+				OpensMenu: true,
+			},
+		},
+	})
+}
+
+func (self *FilesController) openLfsLocksMenu() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LfsLoadingLocksStatus, func(gocui.Task) error {
+		locks, err := self.c.Git().Lfs.GetLocks()
+		if err != nil {
+			return err
+		}
+
+		if len(locks) == 0 {
+			self.c.Toast(self.c.Tr.LfsNoLocks)
+			return nil
+		}
+
+		menuItems := lo.Map(locks, func(lock *models.LfsLock, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				LabelColumns: []string{lock.Path, style.FgCyan.Sprint(lock.Owner)},
+				OnPress: func() error {
+					return self.openLfsUnlockMenu(lock)
+				},
+				OpensMenu: true,
+			}
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: self.c.Tr.LfsLocksTitle,
+				Items: menuItems,
+			})
+		})
+
+		return nil
+	})
+}
+
+func (self *FilesController) rerereMenuDisabledReason() *types.DisabledReason {
+	if !self.c.Git().Rerere.IsEnabled() {
+		return &types.DisabledReason{Text: self.c.Tr.RerereNotEnabled}
+	}
+
+	return nil
+}
+
+func (self *FilesController) stageHunksOneByOneDisabledReason() *types.DisabledReason {
+	if len(self.c.Helpers().Staging.HunkWalkthroughFiles()) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoUnstagedHunks}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openRerereMenu() error {
+	var forgetDisabledReason *types.DisabledReason
+	file := self.getSelectedFile()
+	if file == nil || !file.HasInlineMergeConflicts {
+		forgetDisabledReason = &types.DisabledReason{Text: self.c.Tr.ForgetRerereNeedsConflict}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RerereMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.ForgetRerereResolution,
+				Tooltip: self.c.Tr.ForgetRerereResolutionTooltip,
+				OnPress: func() error {
+					return self.c.Helpers().Rerere.Forget(file.Name)
+				},
+				DisabledReason: forgetDisabledReason,
+				Key:            'f',
+			},
+			{
+				Label: self.c.Tr.ViewRerereResolutions,
+				OnPress: func() error {
+					return self.c.Helpers().Rerere.ViewResolutions(self.context())
+				},
+				Key: 'v',
+			},
+		},
+	})
+}
+
+func (self *FilesController) openLfsUnlockMenu(lock *models.LfsLock) error {
+	unlock := func(force bool) error {
+		return self.c.WithWaitingStatus(self.c.Tr.LfsUnlockingStatus, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+			return self.c.Git().Lfs.UnlockByID(lock.ID, force)
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: lock.Path,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.LfsUnlock,
+				OnPress: func() error { return unlock(false) },
+				Key:     'u',
+			},
+			{
+				Label:   self.c.Tr.LfsForceUnlock,
+				OnPress: func() error { return unlock(true) },
+				Key:     'f',
+				Tooltip: self.c.Tr.LfsForceUnlockTooltip,
+			},
+		},
+	})
+}
+
 func (self *FilesController) openDiffTool(node *filetree.FileNode) error {
 	fromCommit := ""
 	reverse := false
@@ -784,6 +1056,21 @@ func (self *FilesController) createStashMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				Label:   self.c.Tr.StashSelectedFiles,
+				Tooltip: self.c.Tr.StashSelectedFilesTooltip,
+				OnPress: func() error {
+					nodes, _, _ := self.context().GetSelectedItems()
+					paths := lo.Map(nodes, func(node *filetree.FileNode, _ int) string {
+						return node.GetPath()
+					})
+					return self.handleStashSave(func(message string) error {
+						return self.c.Git().Stash.StashPaths(message, paths)
+					}, self.c.Tr.Actions.StashSelectedFiles)
+				},
+				DisabledReason: self.require(self.itemsSelected())(),
+				Key:            'f',
+			},
 		},
 	})
 }
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type FilteringMenuAction struct {
@@ -32,7 +34,7 @@ func (self *FilteringMenuAction) Call() error {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label: fmt.Sprintf("%s '%s'", self.c.Tr.FilterBy, fileName),
 			OnPress: func() error {
-				return self.setFiltering(fileName)
+				return self.setFilteringPath(fileName)
 			},
 		})
 	}
@@ -44,12 +46,45 @@ func (self *FilteringMenuAction) Call() error {
 				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
 				Title:               self.c.Tr.EnterFileName,
 				HandleConfirm: func(response string) error {
-					return self.setFiltering(strings.TrimSpace(response))
+					return self.setFilteringPath(strings.TrimSpace(response))
 				},
 			})
 		},
 	})
 
+	logFilter := self.c.Modes().Filtering.GetLogFilter()
+	menuItems = append(menuItems,
+		self.logFilterMenuItem(self.c.Tr.FilterAuthorOption, self.c.Tr.EnterAuthor, logFilter.Author,
+			self.c.Helpers().Suggestions.GetAuthorsSuggestionsFunc(),
+			func(logFilter *git_commands.LogFilter, value string) { logFilter.Author = value }),
+		self.logFilterMenuItem(self.c.Tr.FilterMessageOption, self.c.Tr.EnterCommitMessageFilter, logFilter.Message, nil,
+			func(logFilter *git_commands.LogFilter, value string) { logFilter.Message = value }),
+		self.logFilterMenuItem(self.c.Tr.FilterSinceOption, self.c.Tr.EnterSinceDate, logFilter.Since, nil,
+			func(logFilter *git_commands.LogFilter, value string) { logFilter.Since = value }),
+		self.logFilterMenuItem(self.c.Tr.FilterUntilOption, self.c.Tr.EnterUntilDate, logFilter.Until, nil,
+			func(logFilter *git_commands.LogFilter, value string) { logFilter.Until = value }),
+		self.logFilterMenuItem(self.c.Tr.FilterContentOption, self.c.Tr.EnterContentFilter, contentFilterValue(logFilter, false), nil,
+			func(logFilter *git_commands.LogFilter, value string) {
+				logFilter.Content = value
+				logFilter.ContentIsRegex = false
+			}),
+		self.logFilterMenuItem(self.c.Tr.FilterContentRegexOption, self.c.Tr.EnterContentRegexFilter, contentFilterValue(logFilter, true), nil,
+			func(logFilter *git_commands.LogFilter, value string) {
+				logFilter.Content = value
+				logFilter.ContentIsRegex = true
+			}),
+		&types.MenuItem{
+			Label:   lo.Ternary(logFilter.UnsignedOnly, self.c.Tr.ShowSignedCommitsToo, self.c.Tr.FilterUnsignedOption),
+			Tooltip: lo.Ternary(logFilter.UnsignedOnly, "", self.c.Tr.FilterUnsignedOptionTooltip),
+			OnPress: func() error {
+				logFilter := self.c.Modes().Filtering.GetLogFilter()
+				logFilter.UnsignedOnly = !logFilter.UnsignedOnly
+				self.c.Modes().Filtering.SetLogFilter(logFilter)
+				return self.applyFiltering()
+			},
+		},
+	)
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
@@ -60,9 +95,54 @@ func (self *FilteringMenuAction) Call() error {
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.FilteringMenuTitle, Items: menuItems})
 }
 
-func (self *FilteringMenuAction) setFiltering(path string) error {
+// Returns a menu item that prompts for a value of one of the log filter's
+// criteria, prefilled with its current value. Entering an empty value removes
+// that criterion.
+func (self *FilteringMenuAction) logFilterMenuItem(
+	label string,
+	promptTitle string,
+	currentValue string,
+	findSuggestionsFunc func(string) []*types.Suggestion,
+	update func(logFilter *git_commands.LogFilter, value string),
+) *types.MenuItem {
+	return &types.MenuItem{
+		Label: label,
+		OnPress: func() error {
+			return self.c.Prompt(types.PromptOpts{
+				Title:               promptTitle,
+				InitialContent:      currentValue,
+				FindSuggestionsFunc: findSuggestionsFunc,
+				HandleConfirm: func(response string) error {
+					logFilter := self.c.Modes().Filtering.GetLogFilter()
+					update(&logFilter, strings.TrimSpace(response))
+					self.c.Modes().Filtering.SetLogFilter(logFilter)
+					return self.applyFiltering()
+				},
+			})
+		},
+	}
+}
+
+// The -S and -G menu items share the same field of the log filter, so we only
+// prefill the one that is currently in use
+func contentFilterValue(logFilter git_commands.LogFilter, isRegex bool) string {
+	if logFilter.ContentIsRegex != isRegex {
+		return ""
+	}
+	return logFilter.Content
+}
+
+func (self *FilteringMenuAction) setFilteringPath(path string) error {
 	self.c.Modes().Filtering.SetPath(path)
 
+	return self.applyFiltering()
+}
+
+func (self *FilteringMenuAction) applyFiltering() error {
+	if !self.c.Modes().Filtering.Active() {
+		return self.c.Helpers().Mode.ClearFiltering()
+	}
+
 	repoState := self.c.State().GetRepoState()
 	if repoState.GetScreenMode() == types.SCREEN_NORMAL {
 		repoState.SetScreenMode(types.SCREEN_HALF)
@@ -0,0 +1,112 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type BlameHelper struct {
+	c *HelperCommon
+}
+
+func NewBlameHelper(c *HelperCommon) *BlameHelper {
+	return &BlameHelper{
+		c: c,
+	}
+}
+
+// Shows the blame of the given file in the main view. If ref is empty, the
+// file is blamed as it currently is in the working tree.
+func (self *BlameHelper) OpenBlame(filename string, ref string) error {
+	return self.openBlame(filename, ref, 0)
+}
+
+func (self *BlameHelper) openBlame(filename string, ref string, selectedLineIdx int) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingBlame, func(gocui.Task) error {
+		lines, err := self.c.Git().Blame.BlameFile(filename, ref)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showBlame(filename, ref, lines, selectedLineIdx)
+		})
+		return nil
+	})
+}
+
+func (self *BlameHelper) showBlame(filename string, ref string, lines []*models.BlameLine, selectedLineIdx int) error {
+	blameContext := self.c.Contexts().Blame
+	blameContext.SetBlame(filename, ref, lines)
+	blameContext.SetSelection(selectedLineIdx)
+	blameContext.SetParentContext(self.c.CurrentSideContext())
+	blameContext.SetTitleRef(blameTitleRef(filename, ref))
+	blameContext.ClearSearchString()
+	blameContext.GetView().ClearSearch()
+	self.c.ResetViewOrigin(blameContext.GetView())
+
+	if err := self.c.PostRefreshUpdate(blameContext); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(blameContext)
+}
+
+// Blames the file as it was just before the commit that last changed the given
+// line. We keep the selected line index so that the user stays roughly in the
+// same area of the file.
+func (self *BlameHelper) BlamePreviousRevision(line *models.BlameLine, selectedLineIdx int) error {
+	if line.IsNotCommittedYet() {
+		return self.c.ErrorMsg(self.c.Tr.LineNotCommittedYet)
+	}
+
+	if line.PreviousSha == "" {
+		return self.c.ErrorMsg(self.c.Tr.NoPreviousRevision)
+	}
+
+	return self.openBlame(line.PreviousFilename, line.PreviousSha, selectedLineIdx)
+}
+
+// Selects the commit that last changed the given line in the commits panel. We
+// only look at the commits of the current branch; if the commit isn't found in
+// the commits that are currently loaded, we load all of them first.
+func (self *BlameHelper) GoToCommit(line *models.BlameLine) error {
+	if line.IsNotCommittedYet() {
+		return self.c.ErrorMsg(self.c.Tr.LineNotCommittedYet)
+	}
+
+	commitsContext := self.c.Contexts().LocalCommits
+	findCommit := func() (int, bool) {
+		_, index, found := lo.FindIndexOf(self.c.Model().Commits, func(commit *models.Commit) bool {
+			return commit.Sha == line.Sha
+		})
+		return index, found
+	}
+
+	index, found := findCommit()
+	if !found && commitsContext.GetLimitCommits() {
+		commitsContext.SetLimitCommits(false)
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}}); err != nil {
+			return err
+		}
+		index, found = findCommit()
+	}
+
+	if !found {
+		return self.c.ErrorMsg(self.c.Tr.BlamedCommitNotInCurrentBranch)
+	}
+
+	commitsContext.SetSelection(index)
+	return self.c.PushContext(commitsContext)
+}
+
+func blameTitleRef(filename string, ref string) string {
+	if ref == "" {
+		return filename
+	}
+
+	return filename + " @ " + utils.ShortSha(ref)
+}
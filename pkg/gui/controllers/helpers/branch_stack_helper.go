@@ -0,0 +1,201 @@
+package helpers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// A branch stack is the checked-out branch together with all other local
+// branches whose heads are among its commits that haven't made it onto a main
+// branch yet, e.g. one branch per pull request where each builds on the
+// previous one.
+type BranchStackHelper struct {
+	c                    *HelperCommon
+	mergeAndRebaseHelper *MergeAndRebaseHelper
+}
+
+func NewBranchStackHelper(
+	c *HelperCommon,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
+) *BranchStackHelper {
+	return &BranchStackHelper{
+		c:                    c,
+		mergeAndRebaseHelper: mergeAndRebaseHelper,
+	}
+}
+
+// Returns the branches of the current stack from the bottom to the top, with
+// the checked-out branch last
+func (self *BranchStackHelper) StackBranches() []*models.Branch {
+	return stackBranches(self.c.Model().Commits, self.c.Model().Branches, self.c.UserConfig.Git.MainBranches)
+}
+
+func stackBranches(commits []*models.Commit, branches []*models.Branch, mainBranches []string) []*models.Branch {
+	commitIdxBySha := map[string]int{}
+	for i, commit := range commits {
+		if commit.Status == models.StatusMerged {
+			break
+		}
+		if !commit.IsTODO() {
+			commitIdxBySha[commit.Sha] = i
+		}
+	}
+
+	stack := lo.Filter(branches, func(branch *models.Branch, _ int) bool {
+		_, inStack := commitIdxBySha[branch.CommitHash]
+		return inStack && !branch.Head && !branch.DetachedHead && !lo.Contains(mainBranches, branch.Name)
+	})
+	sort.SliceStable(stack, func(i, j int) bool {
+		return commitIdxBySha[stack[i].CommitHash] > commitIdxBySha[stack[j].CommitHash]
+	})
+
+	currentBranch, ok := lo.Find(branches, func(branch *models.Branch) bool {
+		return branch.Head && !branch.DetachedHead
+	})
+	if ok {
+		stack = append(stack, currentBranch)
+	}
+
+	return stack
+}
+
+func (self *BranchStackHelper) OpenMenu() error {
+	stack := self.StackBranches()
+	stackDescription := utils.ResolvePlaceholderString(self.c.Tr.BranchStackDescription, map[string]string{
+		"branches": strings.Join(lo.Map(stack, func(branch *models.Branch, _ int) string {
+			return "  " + branch.Name
+		}), "\n"),
+	})
+
+	var disabledReason *types.DisabledReason
+	if len(stack) == 0 {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.NoBranchStack}
+	} else if self.c.Git().Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.AlreadyRebasing}
+	}
+
+	menuItems := lo.Map(self.rebaseTargets(), func(target string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.RebaseStackOnto, map[string]string{"ref": target}),
+			OnPress: func() error {
+				return self.rebaseStack(target)
+			},
+			DisabledReason: disabledReason,
+			Tooltip:        stackDescription,
+		}
+	})
+	if len(menuItems) > 0 {
+		menuItems[0].Key = 'r'
+	}
+
+	pushDisabledReason := lo.Ternary(len(stack) == 0, disabledReason, nil)
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.PushBranchStack,
+		OnPress: func() error {
+			return self.pushStack(stack)
+		},
+		Key:            'p',
+		DisabledReason: pushDisabledReason,
+		Tooltip:        stackDescription,
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.BranchStack,
+		Items: menuItems,
+	})
+}
+
+// The main branches we can rebase the stack onto: the local ones and their
+// upstreams, or the one in origin if there's no local one
+func (self *BranchStackHelper) rebaseTargets() []string {
+	targets := []string{}
+	for _, mainBranchName := range self.c.UserConfig.Git.MainBranches {
+		localBranch, ok := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool {
+			return branch.Name == mainBranchName && !branch.DetachedHead
+		})
+		if ok {
+			targets = append(targets, localBranch.Name)
+			if localBranch.IsTrackingRemote() {
+				targets = append(targets, localBranch.ShortUpstreamRefName())
+			}
+			continue
+		}
+
+		origin, ok := lo.Find(self.c.Model().Remotes, func(remote *models.Remote) bool {
+			return remote.Name == "origin"
+		})
+		if ok && lo.SomeBy(origin.Branches, func(branch *models.RemoteBranch) bool { return branch.Name == mainBranchName }) {
+			targets = append(targets, "origin/"+mainBranchName)
+		}
+	}
+
+	return targets
+}
+
+func (self *BranchStackHelper) rebaseStack(onto string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.RebaseBranchStack)
+		err := self.c.Git().Rebase.RebaseStack(onto)
+		return self.mergeAndRebaseHelper.CheckMergeOrRebase(err)
+	})
+}
+
+// Pushes the branches one by one, so that a failure of one doesn't stop the
+// others, and shows how each of them went
+func (self *BranchStackHelper) pushStack(stack []*models.Branch) error {
+	// Branches that have never been pushed go to the remote of the checked-out
+	// branch, or to the only remote there is
+	fallbackRemote := stack[len(stack)-1].UpstreamRemote
+	if fallbackRemote == "" && len(self.c.Model().Remotes) == 1 {
+		fallbackRemote = self.c.Model().Remotes[0].Name
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.PushingBranchStackStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.PushBranchStack)
+		results := lo.Map(stack, func(branch *models.Branch, _ int) string {
+			return self.pushStackBranch(task, branch, fallbackRemote)
+		})
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.Alert(self.c.Tr.PushBranchStackResultsTitle, strings.Join(results, "\n"))
+		})
+		return nil
+	})
+}
+
+func (self *BranchStackHelper) pushStackBranch(task gocui.Task, branch *models.Branch, fallbackRemote string) string {
+	placeholders := map[string]string{"branch": branch.Name}
+
+	opts := git_commands.PushOpts{Force: !self.c.UserConfig.Git.DisableForcePushing}
+	if branch.IsTrackingRemote() {
+		opts.UpstreamRemote = branch.UpstreamRemote
+		opts.UpstreamBranch = branch.Name + ":" + branch.UpstreamBranch
+		placeholders["upstream"] = branch.ShortUpstreamRefName()
+	} else if fallbackRemote != "" {
+		opts.UpstreamRemote = fallbackRemote
+		opts.UpstreamBranch = branch.Name
+		opts.SetUpstream = true
+		placeholders["upstream"] = fallbackRemote + "/" + branch.Name
+	} else {
+		return utils.ResolvePlaceholderString(self.c.Tr.PushBranchStackSkipped, placeholders)
+	}
+
+	if err := self.c.Git().Sync.Push(task, opts); err != nil {
+		placeholders["error"] = strings.ReplaceAll(strings.TrimSpace(err.Error()), "\n", "\n    ")
+		return utils.ResolvePlaceholderString(self.c.Tr.PushBranchStackFailed, placeholders)
+	}
+
+	return utils.ResolvePlaceholderString(self.c.Tr.PushBranchStackSucceeded, placeholders)
+}
@@ -0,0 +1,97 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackBranches(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		commits  []*models.Commit
+		branches []*models.Branch
+		expected []string
+	}{
+		{
+			testName: "no other branches",
+			commits: []*models.Commit{
+				{Sha: "sha1"},
+				{Sha: "sha2", Status: models.StatusMerged},
+			},
+			branches: []*models.Branch{
+				{Name: "feature", CommitHash: "sha1", Head: true},
+				{Name: "master", CommitHash: "sha2"},
+			},
+			expected: []string{"feature"},
+		},
+		{
+			testName: "branches are ordered from the bottom of the stack to the top",
+			commits: []*models.Commit{
+				{Sha: "sha1"},
+				{Sha: "sha2"},
+				{Sha: "sha3"},
+				{Sha: "sha4"},
+				{Sha: "sha5", Status: models.StatusMerged},
+			},
+			branches: []*models.Branch{
+				{Name: "part-3", CommitHash: "sha1", Head: true},
+				{Name: "part-1", CommitHash: "sha4"},
+				{Name: "unrelated", CommitHash: "sha9"},
+				{Name: "part-2", CommitHash: "sha2"},
+				{Name: "merged", CommitHash: "sha5"},
+				{Name: "master", CommitHash: "sha5"},
+			},
+			expected: []string{"part-1", "part-2", "part-3"},
+		},
+		{
+			testName: "main branches are never part of the stack",
+			commits: []*models.Commit{
+				{Sha: "sha1"},
+				{Sha: "sha2"},
+			},
+			branches: []*models.Branch{
+				{Name: "feature", CommitHash: "sha1", Head: true},
+				{Name: "main", CommitHash: "sha2"},
+			},
+			expected: []string{"feature"},
+		},
+		{
+			testName: "todo commits during a rebase are ignored",
+			commits: []*models.Commit{
+				{Sha: "sha1", Action: todo.Pick},
+				{Sha: "sha2"},
+			},
+			branches: []*models.Branch{
+				{Name: "feature", CommitHash: "sha2", Head: true},
+				{Name: "other", CommitHash: "sha1"},
+			},
+			expected: []string{"feature"},
+		},
+		{
+			testName: "detached head",
+			commits: []*models.Commit{
+				{Sha: "sha1"},
+				{Sha: "sha2"},
+			},
+			branches: []*models.Branch{
+				{Name: "(HEAD detached at sha1)", CommitHash: "sha1", Head: true, DetachedHead: true},
+				{Name: "feature", CommitHash: "sha2"},
+			},
+			expected: []string{"feature"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			stack := stackBranches(s.commits, s.branches, []string{"master", "main"})
+			assert.Equal(t, s.expected, lo.Map(stack, func(branch *models.Branch, _ int) string {
+				return branch.Name
+			}))
+		})
+	}
+}
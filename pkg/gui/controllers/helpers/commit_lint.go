@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type commitLintViolation struct {
+	// if true, the rule was set to 'block' rather than 'warn'
+	blocking bool
+	// if true, the violation is shown with the description rather than the summary
+	inDescription bool
+	message       string
+}
+
+func (self commitLintViolation) String() string {
+	return lo.Ternary(self.blocking, "✗ ", "⚠ ") + self.message
+}
+
+// Checks a commit message, as entered in the summary and description of the
+// commit message panel, against the configured rules. Only fails if the ticket
+// reference pattern is invalid.
+func lintCommitMessage(
+	lintConfig config.CommitLintConfig,
+	tr *i18n.TranslationSet,
+	summary string,
+	description string,
+) ([]commitLintViolation, error) {
+	violations := []commitLintViolation{}
+	add := func(level string, inDescription bool, message string) {
+		if level != "warn" && level != "block" {
+			return
+		}
+		violations = append(violations, commitLintViolation{
+			blocking:      level == "block",
+			inDescription: inDescription,
+			message:       message,
+		})
+	}
+
+	// The summary is meant to be a single line, but if something else has been
+	// pasted into it, everything after the first line ends up in the body
+	summaryLines := strings.Split(summary, "\n")
+	firstLine := summaryLines[0]
+	bodyLines := summaryLines[1:]
+	if description != "" {
+		bodyLines = append(bodyLines, strings.Split(description, "\n")...)
+	}
+
+	if rule := lintConfig.SummaryLength; rule.Max > 0 && utf8.RuneCountInString(firstLine) > rule.Max {
+		add(rule.Level, false, utils.ResolvePlaceholderString(tr.CommitLintSummaryLength,
+			map[string]string{"max": fmt.Sprint(rule.Max)}))
+	}
+
+	if rule := lintConfig.SummaryTrailingPeriod; strings.HasSuffix(strings.TrimRight(firstLine, " \t"), ".") {
+		add(rule.Level, false, tr.CommitLintSummaryTrailingPeriod)
+	}
+
+	if rule := lintConfig.TicketReference; rule.Pattern != "" && rule.Level != "off" {
+		rgx, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", tr.CommitLintTicketPatternError, err.Error())
+		}
+		if !rgx.MatchString(summary + "\n" + description) {
+			add(rule.Level, false, utils.ResolvePlaceholderString(tr.CommitLintTicketReference,
+				map[string]string{"pattern": rule.Pattern}))
+		}
+	}
+
+	if rule := lintConfig.BlankLineAfterSummary; len(summaryLines) > 1 && strings.TrimSpace(summaryLines[1]) != "" {
+		add(rule.Level, false, tr.CommitLintBlankLineAfterSummary)
+	}
+
+	if rule := lintConfig.BodyLineLength; rule.Max > 0 {
+		tooLong := lo.CountBy(bodyLines, func(line string) bool {
+			return utf8.RuneCountInString(line) > rule.Max
+		})
+		if tooLong > 0 {
+			add(rule.Level, true, utils.ResolvePlaceholderString(tr.CommitLintBodyLineLength,
+				map[string]string{"max": fmt.Sprint(rule.Max), "count": fmt.Sprint(tooLong)}))
+		}
+	}
+
+	return violations, nil
+}
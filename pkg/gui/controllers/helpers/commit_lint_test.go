@@ -0,0 +1,97 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCommitMessage(t *testing.T) {
+	allRules := func(level string) config.CommitLintConfig {
+		return config.CommitLintConfig{
+			SummaryLength:         config.CommitLintLengthRule{Level: level, Max: 20},
+			BodyLineLength:        config.CommitLintLengthRule{Level: level, Max: 10},
+			SummaryTrailingPeriod: config.CommitLintRule{Level: level},
+			TicketReference:       config.CommitLintPatternRule{Level: level, Pattern: `[A-Z]+-\d+`},
+			BlankLineAfterSummary: config.CommitLintRule{Level: level},
+		}
+	}
+
+	scenarios := []struct {
+		testName      string
+		lintConfig    config.CommitLintConfig
+		summary       string
+		description   string
+		expected      []string
+		expectedError string
+	}{
+		{
+			testName:    "all rules off",
+			lintConfig:  allRules("off"),
+			summary:     "A summary that is far too long.",
+			description: "A description line that is too long",
+			expected:    []string{},
+		},
+		{
+			testName:    "no violations",
+			lintConfig:  allRules("block"),
+			summary:     "ABC-123 Fix it",
+			description: "Short line\n\nAnother",
+			expected:    []string{},
+		},
+		{
+			testName:    "every rule broken",
+			lintConfig:  allRules("warn"),
+			summary:     "A summary that is far too long.\nstraight after",
+			description: "A description line that is too long\nshort",
+			expected: []string{
+				"⚠ Summary is longer than 20 characters",
+				"⚠ Summary ends with a period",
+				"⚠ No ticket reference matching '[A-Z]+-\\d+'",
+				"⚠ Summary isn't followed by a blank line",
+				"⚠ 2 description line(s) longer than 10 characters",
+			},
+		},
+		{
+			testName:    "ticket reference in the description",
+			lintConfig:  allRules("block"),
+			summary:     "Fix it",
+			description: "Fixes ABC-1",
+			expected:    []string{"✗ 1 description line(s) longer than 10 characters"},
+		},
+		{
+			testName:    "length is counted in characters",
+			lintConfig:  config.CommitLintConfig{SummaryLength: config.CommitLintLengthRule{Level: "block", Max: 5}},
+			summary:     "ünïcö",
+			description: "",
+			expected:    []string{},
+		},
+		{
+			testName:      "invalid ticket pattern",
+			lintConfig:    config.CommitLintConfig{TicketReference: config.CommitLintPatternRule{Level: "warn", Pattern: "("}},
+			summary:       "Fix it",
+			description:   "",
+			expectedError: "Error in ticketReference pattern: error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	tr := i18n.EnglishTranslationSet()
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			violations, err := lintCommitMessage(s.lintConfig, &tr, s.summary, s.description)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, lo.Map(violations, func(violation commitLintViolation, _ int) string {
+				return violation.String()
+			}))
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package helpers
 
 import (
+	"fmt"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -51,6 +54,26 @@ func (self *CommitsHelper) SetMessageAndDescriptionInView(message string) {
 	self.setCommitSummary(summary)
 	self.setCommitDescription(description)
 	self.c.Contexts().CommitMessage.RenderCommitLength()
+	self.RenderCommitLint()
+}
+
+// Shows the rules that the message in the commit message panel breaks below
+// the summary and description views
+func (self *CommitsHelper) RenderCommitLint() {
+	summary, description := self.getCommitSummary(), self.getCommitDescription()
+	violations := []commitLintViolation{}
+	if summary != "" && !self.isGeneratedCommitSummary(summary) {
+		// an invalid pattern is reported when trying to commit
+		violations, _ = lintCommitMessage(self.c.UserConfig.Git.Commit.Lint, self.c.Tr, summary, description)
+	}
+
+	footer := func(inDescription bool) string {
+		return strings.Join(lo.FilterMap(violations, func(violation commitLintViolation, _ int) (string, bool) {
+			return violation.String(), violation.inDescription == inDescription
+		}), ", ")
+	}
+	self.c.Views().CommitMessage.Footer = footer(false)
+	self.c.Views().CommitDescription.Footer = footer(true)
 }
 
 func (self *CommitsHelper) JoinCommitMessageAndDescription() string {
@@ -144,6 +167,22 @@ func (self *CommitsHelper) HandleCommitConfirm() error {
 		return self.c.ErrorMsg(self.c.Tr.CommitWithoutMessageErr)
 	}
 
+	if self.c.UserConfig.Git.Commit.ConventionalCommits && !self.isConventionalCommitSummary(summary) {
+		return self.c.ErrorMsg(self.c.Tr.ConventionalCommitSummaryError)
+	}
+
+	if !self.isGeneratedCommitSummary(summary) {
+		violations, err := lintCommitMessage(self.c.UserConfig.Git.Commit.Lint, self.c.Tr, summary, description)
+		if err != nil {
+			return self.c.ErrorMsg(err.Error())
+		}
+		blocking := lo.Filter(violations, func(violation commitLintViolation, _ int) bool { return violation.blocking })
+		if len(blocking) > 0 {
+			return self.c.ErrorMsg(self.c.Tr.CommitLintBlockedError + "\n\n" + strings.Join(
+				lo.Map(blocking, func(violation commitLintViolation, _ int) string { return "- " + violation.message }), "\n"))
+		}
+	}
+
 	err := self.c.Contexts().CommitMessage.OnConfirm(summary, description)
 	if err != nil {
 		return err
@@ -152,6 +191,80 @@ func (self *CommitsHelper) HandleCommitConfirm() error {
 	return nil
 }
 
+// Adds a trailer to the message in the commit message panel
+func (self *CommitsHelper) AddTrailer(trailer string, value string) error {
+	message := self.getCommitSummary()
+	if description := self.getCommitDescription(); description != "" {
+		message += "\n\n" + description
+	}
+
+	newMessage, err := self.c.Git().Commit.AddTrailer(message, trailer, value)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.SetMessageAndDescriptionInView(newMessage)
+	return nil
+}
+
+// Asks for the type and scope of a conventional commit, and passes on the
+// start of the summary, e.g. 'feat(parser): '
+func (self *CommitsHelper) PromptForConventionalCommitPrefix(onDone func(prefix string) error) error {
+	menuItems := lo.Map(self.c.UserConfig.Git.Commit.ConventionalCommitTypes, func(commitType string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: commitType,
+			OnPress: func() error {
+				return self.c.Prompt(types.PromptOpts{
+					Title:               self.c.Tr.ConventionalCommitScopeTitle,
+					FindSuggestionsFunc: FuzzySearchFunc(self.conventionalCommitScopes()),
+					HandleConfirm: func(scope string) error {
+						scope = strings.TrimSpace(scope)
+						if scope == "" {
+							return onDone(commitType + ": ")
+						}
+						return onDone(fmt.Sprintf("%s(%s): ", commitType, scope))
+					},
+				})
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ConventionalCommitTypeTitle,
+		Items: menuItems,
+	})
+}
+
+var conventionalCommitSummaryRegexp = regexp.MustCompile(`^\w+(\([^()]*\))?!?: \S`)
+
+func (self *CommitsHelper) isConventionalCommitSummary(summary string) bool {
+	return self.isGeneratedCommitSummary(summary) || conventionalCommitSummaryRegexp.MatchString(summary)
+}
+
+// Summaries that git or lazygit generate for us (or WIP commits that skip the
+// hooks) aren't held to the conventional commit format or the lint rules
+func (self *CommitsHelper) isGeneratedCommitSummary(summary string) bool {
+	skipHookPrefix := self.c.UserConfig.Git.SkipHookPrefix
+	if skipHookPrefix != "" && strings.HasPrefix(summary, skipHookPrefix) {
+		return true
+	}
+
+	return lo.SomeBy([]string{"fixup! ", "squash! ", "amend! "}, func(prefix string) bool {
+		return strings.HasPrefix(summary, prefix)
+	})
+}
+
+// Returns the scopes used in the loaded commits, most recent first
+func (self *CommitsHelper) conventionalCommitScopes() []string {
+	return lo.Uniq(lo.FilterMap(self.c.Model().Commits, func(commit *models.Commit, _ int) (string, bool) {
+		match := conventionalCommitSummaryRegexp.FindStringSubmatch(commit.Name)
+		if match == nil || match[1] == "" {
+			return "", false
+		}
+		return strings.Trim(match[1], "()"), true
+	}))
+}
+
 func (self *CommitsHelper) CloseCommitMessagePanel() error {
 	if self.c.Contexts().CommitMessage.GetPreserveMessage() {
 		message := self.JoinCommitMessageAndDescription()
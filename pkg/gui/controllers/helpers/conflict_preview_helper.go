@@ -0,0 +1,147 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Simulating a rebase takes a couple of git calls per commit, which is fine when
+// the user asks for a preview, but too slow to do on every rebase of a long
+// branch just in case there are conflicts
+const maxCommitsForRebaseConflictWarning = 50
+
+// Simulates merging a ref into the checked-out branch, or rebasing the
+// checked-out branch onto it, so that we know about conflicts before starting
+// the real thing
+type ConflictPreviewHelper struct {
+	c          *HelperCommon
+	refsHelper *RefsHelper
+}
+
+func NewConflictPreviewHelper(
+	c *HelperCommon,
+	refsHelper *RefsHelper,
+) *ConflictPreviewHelper {
+	return &ConflictPreviewHelper{
+		c:          c,
+		refsHelper: refsHelper,
+	}
+}
+
+func (self *ConflictPreviewHelper) IsSupported() bool {
+	return self.c.Git().ConflictPreview.IsSupported()
+}
+
+// Shows the files that would change in the main view and what would conflict
+// in the secondary one
+func (self *ConflictPreviewHelper) ShowMergePreview(ref string) error {
+	return self.showPreview(self.c.Tr.MergePreviewTitle, func() (*git_commands.ConflictPreview, string, error) {
+		return self.previewMerge(ref)
+	})
+}
+
+func (self *ConflictPreviewHelper) ShowRebasePreview(ref string) error {
+	return self.showPreview(self.c.Tr.RebasePreviewTitle, func() (*git_commands.ConflictPreview, string, error) {
+		return self.previewRebase(ref, 0)
+	})
+}
+
+// Returns a description of the conflicts that merging ref would run into, or
+// an empty string if there wouldn't be any or we can't tell (e.g. because the
+// branch is too long to check quickly)
+func (self *ConflictPreviewHelper) MergeConflictWarning(ref string) string {
+	return self.conflictWarning(self.previewMerge(ref))
+}
+
+func (self *ConflictPreviewHelper) RebaseConflictWarning(ref string) string {
+	return self.conflictWarning(self.previewRebase(ref, maxCommitsForRebaseConflictWarning))
+}
+
+func (self *ConflictPreviewHelper) conflictWarning(preview *git_commands.ConflictPreview, summary string, err error) string {
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+
+	if preview == nil || !preview.HasConflicts() {
+		return ""
+	}
+
+	return summary
+}
+
+func (self *ConflictPreviewHelper) showPreview(
+	title string,
+	getPreview func() (*git_commands.ConflictPreview, string, error),
+) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingForConflictsStatus, func(gocui.Task) error {
+		preview, summary, err := getPreview()
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title: title,
+					Task:  types.NewRunPtyTask(self.c.Git().ConflictPreview.ShowPreviewCmdObj(preview).GetCmd()),
+				},
+				Secondary: &types.ViewUpdateOpts{
+					Title: self.c.Tr.ConflictPreviewSummaryTitle,
+					Task:  types.NewRenderStringTask(summary),
+				},
+			})
+		})
+		return nil
+	})
+}
+
+func (self *ConflictPreviewHelper) previewMerge(ref string) (*git_commands.ConflictPreview, string, error) {
+	preview, err := self.c.Git().ConflictPreview.PreviewMerge(ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	placeholders := map[string]string{
+		"ref":              ref,
+		"checkedOutBranch": self.refsHelper.GetCheckedOutRef().Name,
+	}
+	if !preview.HasConflicts() {
+		return preview, utils.ResolvePlaceholderString(self.c.Tr.MergePreviewNoConflicts, placeholders), nil
+	}
+
+	summary := utils.ResolvePlaceholderString(self.c.Tr.MergePreviewConflicts, placeholders)
+	return preview, summary + formatConflictedFiles(preview.ConflictedFiles), nil
+}
+
+func (self *ConflictPreviewHelper) previewRebase(ref string, maxCommits int) (*git_commands.ConflictPreview, string, error) {
+	preview, err := self.c.Git().ConflictPreview.PreviewRebase(ref, self.c.Modes().MarkedBaseCommit.GetSha(), maxCommits)
+	if err != nil || preview == nil {
+		return nil, "", err
+	}
+
+	placeholders := map[string]string{
+		"ref":              ref,
+		"checkedOutBranch": self.refsHelper.GetCheckedOutRef().Name,
+	}
+	if !preview.HasConflicts() {
+		return preview, utils.ResolvePlaceholderString(self.c.Tr.RebasePreviewNoConflicts, placeholders), nil
+	}
+
+	placeholders["commit"] = utils.ShortSha(preview.ConflictingCommitSha)
+	placeholders["subject"] = preview.ConflictingCommitSubject
+	summary := utils.ResolvePlaceholderString(self.c.Tr.RebasePreviewConflicts, placeholders)
+	return preview, summary + formatConflictedFiles(preview.ConflictedFiles), nil
+}
+
+func formatConflictedFiles(files []string) string {
+	return strings.Join(lo.Map(files, func(file string, _ int) string {
+		return "\n  " + file
+	}), "")
+}
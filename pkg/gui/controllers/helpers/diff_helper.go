@@ -38,8 +38,8 @@ func (self *DiffHelper) DiffArgs() []string {
 	file := self.currentlySelectedFilename()
 	if file != "" {
 		output = append(output, file)
-	} else if self.c.Modes().Filtering.Active() {
-		output = append(output, self.c.Modes().Filtering.GetPath())
+	} else if path := self.c.Modes().Filtering.GetPath(); path != "" {
+		output = append(output, path)
 	}
 
 	return output
@@ -1,26 +1,33 @@
 package helpers
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"sync"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
 type FixupHelper struct {
-	c *HelperCommon
+	c                    *HelperCommon
+	mergeAndRebaseHelper *MergeAndRebaseHelper
 }
 
 func NewFixupHelper(
 	c *HelperCommon,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
 ) *FixupHelper {
 	return &FixupHelper{
-		c: c,
+		c:                    c,
+		mergeAndRebaseHelper: mergeAndRebaseHelper,
 	}
 }
 
@@ -195,3 +202,318 @@ func (self *FixupHelper) blameDeletedLines(deletedLineInfos []*deletedLineInfo)
 
 	return result.ToSlice()
 }
+
+// A hunk of the staged changes, without context lines, along with the commit
+// that it's going to be absorbed into
+type absorbHunk struct {
+	filename   string
+	fileHeader []string
+	// the hunk header followed by its body; empty for files without hunks,
+	// e.g. binary ones
+	lines    []string
+	oldStart int
+	oldCount int
+	newStart int
+	// new, deleted, renamed and binary files are never absorbed
+	unsupportedFile bool
+
+	// the commit to fix up, if we found one
+	commit *models.Commit
+	// otherwise, why we didn't
+	reason string
+}
+
+// Identifies a hunk across diffs in which the line numbers have moved on
+func (self *absorbHunk) key() string {
+	return self.filename + "\n" + strings.Join(self.lines[1:], "\n")
+}
+
+func (self *absorbHunk) location() string {
+	if len(self.lines) == 0 {
+		return self.filename
+	}
+	return fmt.Sprintf("%s:%d", self.filename, self.newStart)
+}
+
+func (self *FixupHelper) HandleAbsorbPress() error {
+	if self.c.Git().Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return self.c.ErrorMsg(self.c.Tr.AbsorbWhileRebasingError)
+	}
+
+	// Blaming every hunk can take a while in a big repo, so we do it in the
+	// background
+	return self.c.WithWaitingStatus(self.c.Tr.FindingAbsorbTargetsStatus, func(gocui.Task) error {
+		diff, err := self.c.Git().Diff.DiffIndexCmdObj("--cached", "-U0", "--ignore-submodules=all", "HEAD", "--").RunWithOutput()
+		if err != nil {
+			return err
+		}
+		if diff == "" {
+			return errors.New(self.c.Tr.AbsorbNoStagedChanges)
+		}
+
+		hunks := parseDiffIntoAbsorbHunks(diff)
+		self.assignAbsorbHunks(hunks)
+
+		self.c.OnUIThread(func() error {
+			return self.showAbsorbMenu(hunks)
+		})
+		return nil
+	})
+}
+
+func (self *FixupHelper) showAbsorbMenu(hunks []*absorbHunk) error {
+	assigned := lo.Filter(hunks, func(hunk *absorbHunk, _ int) bool { return hunk.commit != nil })
+	unassigned := lo.Filter(hunks, func(hunk *absorbHunk, _ int) bool { return hunk.commit == nil })
+	if len(assigned) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.AbsorbNothingAssigned + "\n\n" + formatUnassignedAbsorbHunks(unassigned))
+	}
+
+	plan := self.c.Tr.AbsorbPlan + "\n\n" + strings.Join(lo.Map(assigned, func(hunk *absorbHunk, _ int) string {
+		return fmt.Sprintf("%s → %s %s", hunk.location(), hunk.commit.ShortSha(), hunk.commit.Name)
+	}), "\n")
+	if len(unassigned) > 0 {
+		plan += "\n\n" + self.c.Tr.AbsorbUnassignedHunks + "\n\n" + formatUnassignedAbsorbHunks(unassigned)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AbsorbTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.AbsorbCreateFixupCommits,
+				Key:     'f',
+				Tooltip: plan,
+				OnPress: func() error {
+					return self.absorb(assigned, unassigned, false)
+				},
+			},
+			{
+				Label:   self.c.Tr.AbsorbCreateFixupCommitsAndSquash,
+				Key:     's',
+				Tooltip: plan,
+				OnPress: func() error {
+					return self.absorb(assigned, unassigned, true)
+				},
+			},
+		},
+	})
+}
+
+func (self *FixupHelper) absorb(assigned []*absorbHunk, unassigned []*absorbHunk, squash bool) error {
+	// Oldest first, so that the fixup! commits end up in the same order as the
+	// commits they fix up
+	commits := lo.Reverse(lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return lo.ContainsBy(assigned, func(hunk *absorbHunk) bool { return hunk.commit == commit })
+	}))
+
+	remainingHunkKeys := map[string][]string{}
+	for _, hunk := range assigned {
+		remainingHunkKeys[hunk.commit.Sha] = append(remainingHunkKeys[hunk.commit.Sha], hunk.key())
+	}
+	selectPatch := func(sha string, diff string) string {
+		keys := remainingHunkKeys[sha]
+		selected := lo.Filter(parseDiffIntoAbsorbHunks(diff), func(hunk *absorbHunk, _ int) bool {
+			if hunk.unsupportedFile || len(hunk.lines) == 0 {
+				return false
+			}
+			if idx := lo.IndexOf(keys, hunk.key()); idx != -1 {
+				keys = append(keys[:idx], keys[idx+1:]...)
+				return true
+			}
+			return false
+		})
+		return formatAbsorbHunksAsPatch(selected)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.AbsorbingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.Absorb)
+		shas := lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Sha })
+		err := self.c.Git().Patch.CreateFixupCommitsFromStagedChanges(shas, selectPatch)
+		if err == nil && squash {
+			err = self.c.Git().Rebase.SquashAllAboveFixupCommits(commits[0])
+		}
+		if err := self.mergeAndRebaseHelper.CheckMergeOrRebase(err); err != nil {
+			return err
+		}
+
+		if len(unassigned) > 0 {
+			self.c.OnUIThread(func() error {
+				return self.c.Alert(self.c.Tr.AbsorbTitle, self.c.Tr.AbsorbUnassignedHunks+"\n\n"+formatUnassignedAbsorbHunks(unassigned))
+			})
+		}
+		return nil
+	})
+}
+
+// Blames the lines that each hunk deletes, or for hunks that only add lines,
+// the lines on either side of them, and assigns the hunk to the commit that
+// they all come from, if it's on the current branch
+func (self *FixupHelper) assignAbsorbHunks(hunks []*absorbHunk) {
+	var wg sync.WaitGroup
+	for _, hunk := range hunks {
+		if hunk.unsupportedFile {
+			hunk.reason = self.c.Tr.AbsorbHunkUnsupportedFile
+			continue
+		}
+
+		wg.Add(1)
+		go func(hunk *absorbHunk) {
+			defer wg.Done()
+
+			shas := set.New[string]()
+			blame := func(firstLine int, numLines int) error {
+				blameOutput, err := self.c.Git().Blame.BlameLineRange(hunk.filename, "HEAD", firstLine, numLines)
+				if err != nil {
+					return err
+				}
+				for _, line := range strings.Split(strings.TrimSuffix(blameOutput, "\n"), "\n") {
+					// Boundary commits are prefixed with a caret
+					shas.Add(strings.TrimPrefix(strings.Split(line, " ")[0], "^"))
+				}
+				return nil
+			}
+
+			if hunk.oldCount > 0 {
+				if err := blame(hunk.oldStart, hunk.oldCount); err != nil {
+					self.c.Log.Errorf("Error blaming file '%s': %v", hunk.filename, err)
+				}
+			} else {
+				// The line after the insertion point doesn't exist if the lines
+				// were added at the end of the file, so we ignore errors here
+				for _, line := range []int{hunk.oldStart, hunk.oldStart + 1} {
+					if line > 0 {
+						_ = blame(line, 1)
+					}
+				}
+			}
+
+			blamedShas := shas.ToSlice()
+			switch {
+			case len(blamedShas) == 0:
+				hunk.reason = self.c.Tr.AbsorbHunkUnknownCommit
+			case len(blamedShas) > 1:
+				hunk.reason = self.c.Tr.AbsorbHunkSeveralCommits
+			default:
+				sha := blamedShas[0]
+				_, index, ok := lo.FindIndexOf(self.c.Model().Commits, func(commit *models.Commit) bool {
+					return strings.HasPrefix(commit.Sha, sha)
+				})
+				if !ok || self.c.Model().Commits[index].Status == models.StatusMerged {
+					hunk.reason = self.c.Tr.AbsorbHunkNotOnBranch
+				} else {
+					hunk.commit = self.originalCommitOfFixup(index)
+				}
+			}
+		}(hunk)
+	}
+	wg.Wait()
+}
+
+// If the commit at the given index is itself a fixup! (or squash! or amend!)
+// commit, returns the commit further down the branch that it belongs to, so that
+// we don't end up with fixups of fixups
+func (self *FixupHelper) originalCommitOfFixup(index int) *models.Commit {
+	commits := self.c.Model().Commits
+	subject := commits[index].Name
+	for {
+		trimmed, ok := lo.Find([]string{"fixup! ", "squash! ", "amend! "}, func(prefix string) bool {
+			return strings.HasPrefix(subject, prefix)
+		})
+		if !ok {
+			break
+		}
+		subject = strings.TrimPrefix(subject, trimmed)
+	}
+
+	original, ok := lo.Find(commits[index+1:], func(commit *models.Commit) bool {
+		return commit.Name == subject && commit.Status != models.StatusMerged
+	})
+	if !ok {
+		return commits[index]
+	}
+	return original
+}
+
+var absorbHunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,\d+)? @@`)
+
+func parseDiffIntoAbsorbHunks(diff string) []*absorbHunk {
+	hunks := []*absorbHunk{}
+
+	var fileHeader []string
+	var filename string
+	var unsupportedFile bool
+	fileHasHunks := false
+	var currentHunk *absorbHunk
+	finishFile := func() {
+		if fileHeader != nil && !fileHasHunks {
+			hunks = append(hunks, &absorbHunk{filename: filename, fileHeader: fileHeader, unsupportedFile: true})
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git"):
+			finishFile()
+			fileHeader = []string{line}
+			filename, _, _ = strings.Cut(strings.TrimPrefix(line, "diff --git a/"), " b/")
+			unsupportedFile = false
+			fileHasHunks = false
+			currentHunk = nil
+		case currentHunk == nil && fileHeader != nil && !strings.HasPrefix(line, "@@ "):
+			fileHeader = append(fileHeader, line)
+			if name, ok := strings.CutPrefix(line, "--- a/"); ok {
+				// For some reason, the line ends with a tab character if the
+				// file name contains spaces
+				filename = strings.TrimRight(name, "\t")
+			}
+			for _, prefix := range []string{"new file mode", "deleted file mode", "old mode", "rename from", "copy from", "Binary files"} {
+				if strings.HasPrefix(line, prefix) {
+					unsupportedFile = true
+				}
+			}
+		case strings.HasPrefix(line, "@@ "):
+			match := absorbHunkHeaderRegexp.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			oldCount := 1
+			if match[2] != "" {
+				oldCount = utils.MustConvertToInt(match[2])
+			}
+			fileHasHunks = true
+			currentHunk = &absorbHunk{
+				filename:        filename,
+				fileHeader:      fileHeader,
+				lines:           []string{line},
+				oldStart:        utils.MustConvertToInt(match[1]),
+				oldCount:        oldCount,
+				newStart:        utils.MustConvertToInt(match[3]),
+				unsupportedFile: unsupportedFile,
+			}
+			hunks = append(hunks, currentHunk)
+		case currentHunk != nil:
+			currentHunk.lines = append(currentHunk.lines, line)
+		}
+	}
+	finishFile()
+
+	return hunks
+}
+
+func formatAbsorbHunksAsPatch(hunks []*absorbHunk) string {
+	result := ""
+	lastFilename := ""
+	for _, hunk := range hunks {
+		if hunk.filename != lastFilename {
+			result += strings.Join(hunk.fileHeader, "\n") + "\n"
+			lastFilename = hunk.filename
+		}
+		result += strings.Join(hunk.lines, "\n") + "\n"
+	}
+	return result
+}
+
+func formatUnassignedAbsorbHunks(hunks []*absorbHunk) string {
+	return strings.Join(lo.Map(hunks, func(hunk *absorbHunk, _ int) string {
+		return fmt.Sprintf("%s (%s)", hunk.location(), hunk.reason)
+	}), "\n")
+}
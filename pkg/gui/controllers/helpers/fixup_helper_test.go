@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiffIntoAbsorbHunks(t *testing.T) {
+	diff := `diff --git a/file1 b/file1
+index 0123456..1234567 100644
+--- a/file1
++++ b/file1
+@@ -3 +3 @@
+-3
++three
+@@ -5,0 +6,2 @@ func foo() {
++added
++added
+diff --git a/file with spaces b/file with spaces
+index 0123456..1234567 100644
+--- a/file with spaces
++++ b/file with spaces
+@@ -1,2 +0,0 @@
+-deleted
+-deleted
+diff --git a/newfile b/newfile
+new file mode 100644
+index 0000000..1234567
+--- /dev/null
++++ b/newfile
+@@ -0,0 +1 @@
++new
+diff --git a/image.png b/image.png
+index 0123456..1234567 100644
+Binary files a/image.png and b/image.png differ
+`
+
+	hunks := parseDiffIntoAbsorbHunks(diff)
+
+	type hunkSummary struct {
+		location        string
+		oldStart        int
+		oldCount        int
+		unsupportedFile bool
+	}
+	assert.Equal(t,
+		[]hunkSummary{
+			{"file1:3", 3, 1, false},
+			{"file1:6", 5, 0, false},
+			{"file with spaces:0", 1, 2, false},
+			{"newfile:1", 0, 0, true},
+			{"image.png", 0, 0, true},
+		},
+		lo.Map(hunks, func(hunk *absorbHunk, _ int) hunkSummary {
+			return hunkSummary{hunk.location(), hunk.oldStart, hunk.oldCount, hunk.unsupportedFile}
+		}))
+
+	assert.Equal(t, `diff --git a/file1 b/file1
+index 0123456..1234567 100644
+--- a/file1
++++ b/file1
+@@ -5,0 +6,2 @@ func foo() {
++added
++added
+diff --git a/file with spaces b/file with spaces
+index 0123456..1234567 100644
+--- a/file with spaces
++++ b/file with spaces
+@@ -1,2 +0,0 @@
+-deleted
+-deleted
+`, formatAbsorbHunksAsPatch([]*absorbHunk{hunks[1], hunks[2]}))
+}
@@ -26,6 +26,7 @@ type Helpers struct {
 	Tags           *TagsHelper
 	MergeAndRebase *MergeAndRebaseHelper
 	MergeConflicts *MergeConflictsHelper
+	MergeEditor    *MergeEditorHelper
 	CherryPick     *CherryPickHelper
 	Host           *HostHelper
 	PatchBuilding  *PatchBuildingHelper
@@ -34,6 +35,8 @@ type Helpers struct {
 	Upstream       *UpstreamHelper
 	AmendHelper    *AmendHelper
 	FixupHelper    *FixupHelper
+	SplitCommit    *SplitCommitHelper
+	BranchStack    *BranchStackHelper
 	Commits        *CommitsHelper
 	Snake          *SnakeHelper
 	// lives in context package because our contexts need it to render to main
@@ -52,6 +55,11 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Blame             *BlameHelper
+	Lfs               *LfsHelper
+	RangeDiff         *RangeDiffHelper
+	ConflictPreview   *ConflictPreviewHelper
+	Rerere            *RerereHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -64,6 +72,7 @@ func NewStubHelpers() *Helpers {
 		Tags:              &TagsHelper{},
 		MergeAndRebase:    &MergeAndRebaseHelper{},
 		MergeConflicts:    &MergeConflictsHelper{},
+		MergeEditor:       &MergeEditorHelper{},
 		CherryPick:        &CherryPickHelper{},
 		Host:              &HostHelper{},
 		PatchBuilding:     &PatchBuildingHelper{},
@@ -72,6 +81,8 @@ func NewStubHelpers() *Helpers {
 		Upstream:          &UpstreamHelper{},
 		AmendHelper:       &AmendHelper{},
 		FixupHelper:       &FixupHelper{},
+		SplitCommit:       &SplitCommitHelper{},
+		BranchStack:       &BranchStackHelper{},
 		Commits:           &CommitsHelper{},
 		Snake:             &SnakeHelper{},
 		Diff:              &DiffHelper{},
@@ -89,5 +100,10 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Blame:             &BlameHelper{},
+		Lfs:               &LfsHelper{},
+		RangeDiff:         &RangeDiffHelper{},
+		ConflictPreview:   &ConflictPreviewHelper{},
+		Rerere:            &RerereHelper{},
 	}
 }
@@ -0,0 +1,110 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type LfsHelper struct {
+	c *HelperCommon
+}
+
+func NewLfsHelper(c *HelperCommon) *LfsHelper {
+	return &LfsHelper{
+		c: c,
+	}
+}
+
+// A diff of an LFS file only shows the change to its pointer, which tells the
+// user nothing useful, so we show a summary of the old and new objects instead.
+// Staged means we're comparing HEAD to the index, otherwise the index to the
+// working tree.
+func (self *LfsHelper) WorkingTreeDiffSummary(file *models.File, staged bool) string {
+	lfs := self.c.Git().Lfs
+
+	if staged {
+		oldPath := lo.Ternary(file.IsRename(), file.PreviousName, file.Name)
+		return self.diffSummary(file.Name, lfs.PointerAt("HEAD", oldPath), lfs.PointerAt("", file.Name))
+	}
+
+	return self.diffSummary(file.Name, lfs.PointerAt("", file.Name), lfs.WorkingTreePointer(file.Name))
+}
+
+// Returns a summary of the change to the file between the two refs, and false
+// if the file isn't stored in LFS at either of them. This runs whenever a commit
+// file is selected, so we don't look at the file's content unless it's meant to
+// be stored in LFS.
+func (self *LfsHelper) RefDiffSummary(from string, to string, reverse bool, path string) (string, bool) {
+	lfs := self.c.Git().Lfs
+	if !lfs.HasLfsFilter(path) {
+		return "", false
+	}
+
+	oldPointer := lfs.PointerAt(from, path)
+	newPointer := lfs.PointerAt(to, path)
+	if oldPointer == nil && newPointer == nil {
+		return "", false
+	}
+
+	if reverse {
+		oldPointer, newPointer = newPointer, oldPointer
+	}
+
+	return self.diffSummary(path, oldPointer, newPointer), true
+}
+
+func (self *LfsHelper) diffSummary(path string, oldPointer *git_commands.LfsPointer, newPointer *git_commands.LfsPointer) string {
+	formatPointer := func(pointer *git_commands.LfsPointer) string {
+		if pointer == nil {
+			return self.c.Tr.LfsNoObject
+		}
+
+		size := utils.FormatBytes(pointer.Size)
+		// we don't hash the working tree file, so we only know its size
+		if pointer.Oid == "" {
+			return fmt.Sprintf(self.c.Tr.LfsWorkingTreeObject, size)
+		}
+
+		return fmt.Sprintf("%s (%s)", pointer.Oid, size)
+	}
+
+	lines := []string{
+		style.AttrBold.Sprintf(self.c.Tr.LfsObjectTitle, path),
+		"",
+		style.FgRed.Sprintf("- %s: %s", self.c.Tr.LfsOldObject, formatPointer(oldPointer)),
+		style.FgGreen.Sprintf("+ %s: %s", self.c.Tr.LfsNewObject, formatPointer(newPointer)),
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Asks for confirmation if any of the staged files are too large to
+// comfortably store in git and aren't tracked by LFS
+func (self *LfsHelper) WithLargeFileCheck(handler func() error) error {
+	threshold := self.c.UserConfig.Git.LargeFileWarningSize
+	if threshold <= 0 {
+		return handler()
+	}
+
+	largeFiles := self.c.Git().Lfs.LargeNonLfsFiles(self.c.Model().Files, threshold)
+	if len(largeFiles) == 0 {
+		return handler()
+	}
+
+	fileList := strings.Join(lo.Map(largeFiles, func(file *models.File, _ int) string {
+		return "  " + file.Name
+	}), "\n")
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.LargeFilesWarningTitle,
+		Prompt:        fmt.Sprintf(self.c.Tr.LargeFilesWarningPrompt, utils.FormatBytes(threshold), fileList),
+		HandleConfirm: handler,
+	})
+}
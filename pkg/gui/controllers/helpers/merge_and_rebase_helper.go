@@ -15,17 +15,20 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c          *HelperCommon
-	refsHelper *RefsHelper
+	c                     *HelperCommon
+	refsHelper            *RefsHelper
+	conflictPreviewHelper *ConflictPreviewHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
 	refsHelper *RefsHelper,
+	conflictPreviewHelper *ConflictPreviewHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		refsHelper: refsHelper,
+		c:                     c,
+		refsHelper:            refsHelper,
+		conflictPreviewHelper: conflictPreviewHelper,
 	}
 }
 
@@ -48,7 +51,8 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 		{option: REBASE_OPTION_ABORT, key: 'a'},
 	}
 
-	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
+	workingTreeState := self.c.Git().Status.WorkingTreeState()
+	if workingTreeState == enums.REBASE_MODE_REBASING || workingTreeState == enums.REBASE_MODE_APPLYING {
 		options = append(options, optionAndKey{
 			option: REBASE_OPTION_SKIP, key: 's',
 		})
@@ -65,9 +69,12 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 	})
 
 	var title string
-	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_MERGING {
+	switch workingTreeState {
+	case enums.REBASE_MODE_MERGING:
 		title = self.c.Tr.MergeOptionsTitle
-	} else {
+	case enums.REBASE_MODE_APPLYING:
+		title = self.c.Tr.ApplyPatchesOptionsTitle
+	default:
 		title = self.c.Tr.RebaseOptionsTitle
 	}
 
@@ -77,10 +84,13 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 	status := self.c.Git().Status.WorkingTreeState()
 
-	if status != enums.REBASE_MODE_MERGING && status != enums.REBASE_MODE_REBASING {
+	if status != enums.REBASE_MODE_MERGING && status != enums.REBASE_MODE_REBASING && status != enums.REBASE_MODE_APPLYING {
 		return self.c.ErrorMsg(self.c.Tr.NotMergingOrRebasing)
 	}
 
+	// Whichever way we go, we're moving on from the commit that was being split
+	self.c.Modes().SplittingCommit.Reset()
+
 	self.c.LogAction(fmt.Sprintf("Merge/Rebase: %s", command))
 	if status == enums.REBASE_MODE_REBASING {
 		todoFile, err := os.ReadFile(
@@ -102,6 +112,8 @@ func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 		commandType = "merge"
 	case enums.REBASE_MODE_REBASING:
 		commandType = "rebase"
+	case enums.REBASE_MODE_APPLYING:
+		commandType = "am"
 	default:
 		// shouldn't be possible to land here
 	}
@@ -216,6 +228,8 @@ func (self *MergeAndRebaseHelper) workingTreeStateNoun() string {
 		return ""
 	case enums.REBASE_MODE_MERGING:
 		return "merge"
+	case enums.REBASE_MODE_APPLYING:
+		return "patch application"
 	default:
 		return "rebase"
 	}
@@ -233,6 +247,13 @@ func (self *MergeAndRebaseHelper) PromptToContinueRebase() error {
 }
 
 func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
+	return self.withConflictWarning(
+		func() string { return self.conflictPreviewHelper.RebaseConflictWarning(ref) },
+		func(warning string) error { return self.showRebaseMenu(ref, warning) },
+	)
+}
+
+func (self *MergeAndRebaseHelper) showRebaseMenu(ref string, conflictWarning string) error {
 	checkedOutBranch := self.refsHelper.GetCheckedOutRef().Name
 	menuItems := []*types.MenuItem{
 		{
@@ -280,6 +301,12 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 		},
 	}
 
+	if conflictWarning != "" {
+		for _, item := range menuItems {
+			item.Tooltip = strings.TrimSpace(conflictWarning + "\n\n" + item.Tooltip)
+		}
+	}
+
 	title := utils.ResolvePlaceholderString(
 		lo.Ternary(self.c.Modes().MarkedBaseCommit.GetSha() != "",
 			self.c.Tr.RebasingFromBaseCommitTitle,
@@ -312,14 +339,37 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 		},
 	)
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.MergeConfirmTitle,
-		Prompt: prompt,
-		HandleConfirm: func() error {
-			self.c.LogAction(self.c.Tr.Actions.Merge)
-			err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{})
-			return self.CheckMergeOrRebase(err)
+	return self.withConflictWarning(
+		func() string { return self.conflictPreviewHelper.MergeConflictWarning(refName) },
+		func(warning string) error {
+			return self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.MergeConfirmTitle,
+				Prompt: lo.Ternary(warning != "", warning+"\n\n"+prompt, prompt),
+				HandleConfirm: func() error {
+					self.c.LogAction(self.c.Tr.Actions.Merge)
+					err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{})
+					return self.CheckMergeOrRebase(err)
+				},
+			})
 		},
+	)
+}
+
+// Simulates the merge or rebase in the background before continuing, so that
+// the user can be warned about conflicts. The warning is empty if there won't
+// be any, if the git version is too old to tell, or if the user has turned
+// the check off.
+func (self *MergeAndRebaseHelper) withConflictWarning(getWarning func() string, then func(warning string) error) error {
+	if !self.c.UserConfig.Git.Merging.WarnAboutConflicts || !self.conflictPreviewHelper.IsSupported() {
+		return then("")
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingForConflictsStatus, func(gocui.Task) error {
+		warning := getWarning()
+		self.c.OnUIThread(func() error {
+			return then(warning)
+		})
+		return nil
 	})
 }
 
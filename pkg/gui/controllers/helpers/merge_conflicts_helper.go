@@ -1,19 +1,24 @@
 package helpers
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type MergeConflictsHelper struct {
-	c *HelperCommon
+	c            *HelperCommon
+	rerereHelper *RerereHelper
 }
 
 func NewMergeConflictsHelper(
 	c *HelperCommon,
+	rerereHelper *RerereHelper,
 ) *MergeConflictsHelper {
 	return &MergeConflictsHelper{
-		c: c,
+		c:            c,
+		rerereHelper: rerereHelper,
 	}
 }
 
@@ -89,6 +94,9 @@ func (self *MergeConflictsHelper) SwitchToMerge(path string) error {
 			return err
 		}
 		if !hasConflicts {
+			if self.isResolvedByRerere(path) {
+				return self.rerereHelper.ShowResolvedByRerereNotice(path)
+			}
 			return nil
 		}
 	}
@@ -96,6 +104,14 @@ func (self *MergeConflictsHelper) SwitchToMerge(path string) error {
 	return self.c.PushContext(self.c.Contexts().MergeConflicts)
 }
 
+func (self *MergeConflictsHelper) isResolvedByRerere(path string) bool {
+	file, ok := lo.Find(self.c.Model().Files, func(file *models.File) bool {
+		return file.Name == path
+	})
+
+	return ok && file.IsResolvedByRerere
+}
+
 func (self *MergeConflictsHelper) context() *context.MergeConflictsContext {
 	return self.c.Contexts().MergeConflicts
 }
@@ -0,0 +1,282 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The merge editor shows one conflict of the file in the merge conflicts
+// context at a time, with our version, the base version and their version side
+// by side. The user builds up the resolution in the result pane by picking
+// lines from the other panes and/or typing, and then applies it to the file.
+type MergeEditorHelper struct {
+	c                    *HelperCommon
+	mergeConflictsHelper *MergeConflictsHelper
+}
+
+func NewMergeEditorHelper(
+	c *HelperCommon,
+	mergeConflictsHelper *MergeConflictsHelper,
+) *MergeEditorHelper {
+	return &MergeEditorHelper{
+		c:                    c,
+		mergeConflictsHelper: mergeConflictsHelper,
+	}
+}
+
+// Expects the caller to hold the merge conflicts mutex
+func (self *MergeEditorHelper) Open() error {
+	state := self.mergeConflictsContext().GetState()
+	if state.AllConflictsResolved() {
+		return nil
+	}
+
+	self.c.Contexts().MergeEditorResult.SetConflictIndex(state.GetConflictIndex())
+	if err := self.loadConflict(); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(self.c.Contexts().MergeEditorOurs)
+}
+
+func (self *MergeEditorHelper) Close() error {
+	self.withLock(func() {
+		self.mergeConflictsContext().GetState().SelectConflict(
+			self.c.Contexts().MergeEditorResult.GetConflictIndex(),
+		)
+	})
+
+	return self.c.PushContext(self.c.Contexts().MergeConflicts)
+}
+
+func (self *MergeEditorHelper) IsMergeEditorContext(c types.Context) bool {
+	return lo.Contains(
+		[]types.ContextKey{
+			context.MERGE_EDITOR_OURS_CONTEXT_KEY,
+			context.MERGE_EDITOR_BASE_CONTEXT_KEY,
+			context.MERGE_EDITOR_THEIRS_CONTEXT_KEY,
+			context.MERGE_EDITOR_RESULT_CONTEXT_KEY,
+		},
+		c.GetKey(),
+	)
+}
+
+// Returns the context to focus after the given one when cycling through the
+// panes of the merge editor
+func (self *MergeEditorHelper) NextPane(c types.Context) types.Context {
+	panes := []types.Context{
+		self.c.Contexts().MergeEditorOurs,
+		self.c.Contexts().MergeEditorBase,
+		self.c.Contexts().MergeEditorTheirs,
+		self.c.Contexts().MergeEditorResult,
+	}
+	_, index, _ := lo.FindIndexOf(panes, func(pane types.Context) bool {
+		return pane.GetKey() == c.GetKey()
+	})
+
+	return panes[(index+1)%len(panes)]
+}
+
+func (self *MergeEditorHelper) Pick(pane *context.MergeEditorPaneContext) error {
+	lines, _, endIdx := pane.GetSelectedItems()
+	if len(lines) == 0 {
+		return nil
+	}
+
+	self.c.Contexts().MergeEditorResult.AppendLines(lo.Map(lines, func(line *context.MergeEditorLine, _ int) string {
+		return line.Content
+	}))
+	pane.MarkPicked(lines)
+
+	// move on to the next line so that consecutive lines can be picked one
+	// after the other
+	pane.CancelRangeSelect()
+	pane.SetSelection(endIdx + 1)
+
+	return self.c.PostRefreshUpdate(pane)
+}
+
+func (self *MergeEditorHelper) Apply() error {
+	resolution := self.c.Contexts().MergeEditorResult.GetLines()
+	if len(resolution) > 0 {
+		return self.apply(resolution)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.MergeEditorApply,
+		Prompt: self.c.Tr.MergeEditorApplyEmptyPrompt,
+		HandleConfirm: func() error {
+			return self.apply(resolution)
+		},
+	})
+}
+
+func (self *MergeEditorHelper) apply(resolution []string) error {
+	allResolved, err := self.writeResolution(resolution)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if !allResolved {
+		return nil
+	}
+
+	self.mergeConflictsHelper.ResetMergeState()
+	if err := self.c.PushContext(self.c.Contexts().Files); err != nil {
+		return err
+	}
+
+	// as part of refreshing files, we handle the situation where a file has
+	// had its merge conflicts resolved.
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
+
+// Writes the file with the current conflict resolved and moves on to the next
+// conflict, if any. Returns true if there are none left.
+func (self *MergeEditorHelper) writeResolution(resolution []string) (bool, error) {
+	self.mergeConflictsContext().GetMutex().Lock()
+	defer self.mergeConflictsContext().GetMutex().Unlock()
+
+	resultContext := self.c.Contexts().MergeEditorResult
+	state := self.mergeConflictsContext().GetState()
+	state.SelectConflict(resultContext.GetConflictIndex())
+
+	ok, content := state.ContentWithResolution(resolution)
+	if !ok {
+		return false, nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ResolveConflictInMergeEditor)
+	if err := os.WriteFile(state.GetPath(), []byte(content), 0o644); err != nil {
+		return false, err
+	}
+	state.PushContent(content)
+
+	if state.AllConflictsResolved() {
+		return true, nil
+	}
+
+	// the conflict after the one we just resolved now has its index
+	resultContext.SetConflictIndex(utils.Min(resultContext.GetConflictIndex(), state.ConflictCount()-1))
+
+	return false, self.loadConflict()
+}
+
+// Rewrites the conflicts of the current file in zdiff3 style (or diff3 style
+// for git versions that don't support zdiff3) so that they include the base
+// version. This throws away any resolutions made in the file
+// so far, so we ask first.
+func (self *MergeEditorHelper) ShowConflictBase() error {
+	path := self.mergeConflictsContext().GetState().GetPath()
+	inMergeEditor := self.IsMergeEditorContext(self.c.CurrentContext())
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.ShowConflictBase,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.ShowConflictBasePrompt, map[string]string{"path": path}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.ShowConflictBase)
+			conflictStyle := "zdiff3"
+			if self.c.Git().Version.IsOlderThan(2, 35, 0) {
+				conflictStyle = "diff3"
+			}
+			if err := self.c.Git().WorkingTree.RecreateConflict(path, conflictStyle); err != nil {
+				return self.c.Error(err)
+			}
+
+			self.mergeConflictsContext().GetMutex().Lock()
+			defer self.mergeConflictsContext().GetMutex().Unlock()
+
+			hasConflicts, err := self.mergeConflictsHelper.setMergeStateWithoutLock(path)
+			if err != nil {
+				return self.c.Error(err)
+			}
+			if !hasConflicts {
+				return nil
+			}
+
+			if !inMergeEditor {
+				return self.mergeConflictsContext().RenderAndFocus()
+			}
+
+			resultContext := self.c.Contexts().MergeEditorResult
+			resultContext.SetConflictIndex(utils.Min(resultContext.GetConflictIndex(), self.mergeConflictsContext().GetState().ConflictCount()-1))
+			return self.loadConflict()
+		},
+	})
+}
+
+// Expects the caller to hold the merge conflicts mutex
+func (self *MergeEditorHelper) loadConflict() error {
+	state := self.mergeConflictsContext().GetState()
+	resultContext := self.c.Contexts().MergeEditorResult
+	state.SelectConflict(resultContext.GetConflictIndex())
+
+	sides := state.CurrentConflictSides()
+	if sides == nil {
+		return nil
+	}
+
+	basePlaceholder := ""
+	if sides.Base == nil {
+		basePlaceholder = utils.ResolvePlaceholderString(self.c.Tr.MergeEditorNoBase, map[string]string{
+			"key": keybindings.Label(self.c.UserConfig.Keybinding.Main.ShowConflictBase),
+		})
+	}
+
+	panes := []struct {
+		context     *context.MergeEditorPaneContext
+		lines       []string
+		placeholder string
+		title       string
+		label       string
+	}{
+		{self.c.Contexts().MergeEditorOurs, sides.Ours, "", self.c.Tr.MergeEditorOursTitle, sides.OursLabel},
+		{self.c.Contexts().MergeEditorBase, sides.Base, basePlaceholder, self.c.Tr.MergeEditorBaseTitle, sides.BaseLabel},
+		{self.c.Contexts().MergeEditorTheirs, sides.Theirs, "", self.c.Tr.MergeEditorTheirsTitle, sides.TheirsLabel},
+	}
+	for _, pane := range panes {
+		pane.context.SetLines(pane.lines, pane.placeholder)
+		pane.context.SetSelection(0)
+		pane.context.GetView().Title = paneTitle(pane.title, pane.label)
+		if err := self.c.PostRefreshUpdate(pane.context); err != nil {
+			return err
+		}
+	}
+
+	resultContext.GetView().Title = paneTitle(
+		self.c.Tr.MergeEditorResultTitle,
+		utils.ResolvePlaceholderString(self.c.Tr.MergeEditorConflictPosition, map[string]string{
+			"current": strconv.Itoa(state.GetConflictIndex() + 1),
+			"total":   strconv.Itoa(state.ConflictCount()),
+		}),
+	)
+	resultContext.SetContent("")
+
+	return nil
+}
+
+func paneTitle(title string, label string) string {
+	if label == "" {
+		return title
+	}
+
+	return fmt.Sprintf("%s (%s)", title, label)
+}
+
+func (self *MergeEditorHelper) withLock(f func()) {
+	self.mergeConflictsContext().GetMutex().Lock()
+	defer self.mergeConflictsContext().GetMutex().Unlock()
+
+	f()
+}
+
+func (self *MergeEditorHelper) mergeConflictsContext() *context.MergeConflictsContext {
+	return self.c.Contexts().MergeConflicts
+}
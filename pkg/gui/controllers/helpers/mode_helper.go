@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -19,6 +20,7 @@ type ModeHelper struct {
 	cherryPickHelper     *CherryPickHelper
 	mergeAndRebaseHelper *MergeAndRebaseHelper
 	bisectHelper         *BisectHelper
+	splitCommitHelper    *SplitCommitHelper
 	suppressRebasingMode bool
 }
 
@@ -29,6 +31,7 @@ func NewModeHelper(
 	cherryPickHelper *CherryPickHelper,
 	mergeAndRebaseHelper *MergeAndRebaseHelper,
 	bisectHelper *BisectHelper,
+	splitCommitHelper *SplitCommitHelper,
 ) *ModeHelper {
 	return &ModeHelper{
 		c:                    c,
@@ -37,6 +40,7 @@ func NewModeHelper(
 		cherryPickHelper:     cherryPickHelper,
 		mergeAndRebaseHelper: mergeAndRebaseHelper,
 		bisectHelper:         bisectHelper,
+		splitCommitHelper:    splitCommitHelper,
 	}
 }
 
@@ -62,6 +66,19 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			},
 			Reset: self.diffHelper.ExitDiffMode,
 		},
+		{
+			IsActive: self.splitCommitHelper.IsSplitting,
+			Description: func() string {
+				return self.withResetButton(
+					utils.ResolvePlaceholderString(
+						self.c.Tr.SplittingCommitMode,
+						map[string]string{"sha": utils.ShortSha(self.c.Modes().SplittingCommit.GetSha())},
+					),
+					style.FgYellow.SetBold(),
+				)
+			},
+			Reset: self.splitCommitHelper.FinishSplit,
+		},
 		{
 			IsActive: self.c.Git().Patch.PatchBuilder.Active,
 			Description: func() string {
@@ -74,9 +91,9 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			Description: func() string {
 				return self.withResetButton(
 					fmt.Sprintf(
-						"%s '%s'",
+						"%s %s",
 						self.c.Tr.FilteringBy,
-						self.c.Modes().Filtering.GetPath(),
+						self.filteringDescription(),
 					),
 					style.FgRed,
 				)
@@ -137,6 +154,39 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 	}
 }
 
+// e.g. "'pkg/foo.go', author 'Jesse', message 'fix'"
+func (self *ModeHelper) filteringDescription() string {
+	parts := []string{}
+	if path := self.c.Modes().Filtering.GetPath(); path != "" {
+		parts = append(parts, fmt.Sprintf("'%s'", path))
+	}
+
+	logFilter := self.c.Modes().Filtering.GetLogFilter()
+	contentLabel := self.c.Tr.FilterContentLabel
+	if logFilter.ContentIsRegex {
+		contentLabel = self.c.Tr.FilterContentRegexLabel
+	}
+	for _, criterion := range []struct {
+		label string
+		value string
+	}{
+		{self.c.Tr.FilterAuthorLabel, logFilter.Author},
+		{self.c.Tr.FilterMessageLabel, logFilter.Message},
+		{self.c.Tr.FilterSinceLabel, logFilter.Since},
+		{self.c.Tr.FilterUntilLabel, logFilter.Until},
+		{contentLabel, logFilter.Content},
+	} {
+		if criterion.value != "" {
+			parts = append(parts, fmt.Sprintf("%s '%s'", criterion.label, criterion.value))
+		}
+	}
+	if logFilter.UnsignedOnly {
+		parts = append(parts, self.c.Tr.FilterUnsignedLabel)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 func (self *ModeHelper) withResetButton(content string, textStyle style.TextStyle) string {
 	return textStyle.Sprintf(
 		"%s %s",
@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type RangeDiffHelper struct {
+	c *HelperCommon
+}
+
+func NewRangeDiffHelper(c *HelperCommon) *RangeDiffHelper {
+	return &RangeDiffHelper{
+		c: c,
+	}
+}
+
+// Shows the commits of oldRef and newRef side by side (matched up by git
+// range-diff), so that the user can see how a branch changed e.g. across a
+// rebase. Selecting a pair of commits shows their interdiff in the main view.
+func (self *RangeDiffHelper) ViewRangeDiff(oldRef string, newRef string, parentContext types.Context) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingRangeDiff, func(gocui.Task) error {
+		pairs, err := self.c.Git().RangeDiff.GetPairs(oldRef, newRef)
+		if err != nil {
+			return err
+		}
+
+		if len(pairs) == 0 {
+			self.c.Toast(fmt.Sprintf(self.c.Tr.RangeDiffNoCommits, oldRef, newRef))
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showRangeDiff(oldRef, newRef, pairs, parentContext)
+		})
+		return nil
+	})
+}
+
+func (self *RangeDiffHelper) showRangeDiff(oldRef string, newRef string, pairs []*models.RangeDiffPair, parentContext types.Context) error {
+	rangeDiffContext := self.c.Contexts().RangeDiff
+	rangeDiffContext.SetRangeDiff(oldRef, newRef, pairs)
+	rangeDiffContext.SetSelection(0)
+	rangeDiffContext.SetParentContext(parentContext)
+	rangeDiffContext.SetTitleRef(utils.TruncateWithEllipsis(fmt.Sprintf("%s...%s", oldRef, newRef), 50))
+	rangeDiffContext.GetView().TitlePrefix = parentContext.GetView().TitlePrefix
+
+	if err := self.c.PostRefreshUpdate(rangeDiffContext); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(rangeDiffContext)
+}
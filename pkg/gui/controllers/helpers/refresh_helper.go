@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"path"
 	"strings"
 	"sync"
 	"time"
@@ -317,6 +318,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 		git_commands.GetCommitsOptions{
 			Limit:                self.c.Contexts().LocalCommits.GetLimitCommits(),
 			FilterPath:           self.c.Modes().Filtering.GetPath(),
+			Filter:               self.c.Modes().Filtering.GetLogFilter(),
 			IncludeRebaseCommits: true,
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutBranchName,
@@ -331,7 +333,73 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
 	self.c.Model().CheckedOutBranch = checkedOutBranchName
 
-	return self.refreshView(self.c.Contexts().LocalCommits)
+	var unverifiedShas []string
+	if self.c.UserConfig.Git.Log.ShowSignatureStatus {
+		unverifiedShas = self.applyKnownSignatures(commits)
+	}
+
+	if err := self.refreshView(self.c.Contexts().LocalCommits); err != nil {
+		return err
+	}
+
+	if len(unverifiedShas) > 0 {
+		self.loadSignatures(unverifiedShas)
+	}
+
+	return nil
+}
+
+// Sets the signatures of the commits that we've already verified, and returns
+// the SHAs of the ones we haven't. Todo commits of an interactive rebase are
+// left alone; we'll get to them once they've been picked.
+// Signatures of commits that are no longer shown are dropped, so that the
+// cache doesn't keep growing as history gets rewritten.
+func (self *RefreshHelper) applyKnownSignatures(commits []*models.Commit) []string {
+	knownSignatures := self.c.Model().CommitSignatures
+	keptSignatures := map[string]*models.CommitSignature{}
+	unverifiedShas := []string{}
+	for _, commit := range commits {
+		if commit.IsTODO() || commit.Sha == "" {
+			continue
+		}
+
+		if commit.Signature == nil {
+			commit.Signature = knownSignatures[commit.Sha]
+		}
+
+		if commit.Signature != nil {
+			keptSignatures[commit.Sha] = commit.Signature
+		} else {
+			unverifiedShas = append(unverifiedShas, commit.Sha)
+		}
+	}
+	self.c.Model().CommitSignatures = keptSignatures
+
+	return unverifiedShas
+}
+
+// Verifying signatures is slow, so rather than holding up the commits view we
+// do it in the background and render the commits again once we're done.
+func (self *RefreshHelper) loadSignatures(shas []string) {
+	self.c.OnWorker(func(gocui.Task) {
+		signatures, err := self.c.Git().Loaders.CommitLoader.GetSignatures(shas)
+		if err != nil {
+			self.c.Log.Error(err)
+			return
+		}
+
+		self.c.Mutexes().LocalCommitsMutex.Lock()
+		defer self.c.Mutexes().LocalCommitsMutex.Unlock()
+
+		for sha, signature := range signatures {
+			self.c.Model().CommitSignatures[sha] = signature
+		}
+		// the commits may have been refreshed in the meantime, in which case
+		// this picks up whatever they have in common with the ones we verified
+		self.applyKnownSignatures(self.c.Model().Commits)
+
+		_ = self.refreshView(self.c.Contexts().LocalCommits)
+	})
 }
 
 func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
@@ -342,10 +410,12 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 		git_commands.GetCommitsOptions{
 			Limit:                   self.c.Contexts().SubCommits.GetLimitCommits(),
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
+			Filter:                  self.c.Modes().Filtering.GetLogFilter(),
 			IncludeRebaseCommits:    false,
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
 			RefForPushedStatus:      self.c.Contexts().SubCommits.GetRef().FullRefName(),
+			FilterLineRange:         self.c.Contexts().SubCommits.GetFilterLineRange(),
 		},
 	)
 	if err != nil {
@@ -423,6 +493,22 @@ func (self *RefreshHelper) refreshStateSubmoduleConfigs() error {
 	return nil
 }
 
+func (self *RefreshHelper) refreshStateSparseCheckout() {
+	if !self.c.Git().SparseCheckout.MightBeEnabled() {
+		self.c.Model().IsSparseCheckout = false
+		self.c.Model().SparseCheckoutDirectories = nil
+		return
+	}
+
+	dirs, isSparse, err := self.c.Git().SparseCheckout.GetDirectories()
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	self.c.Model().IsSparseCheckout = isSparse
+	self.c.Model().SparseCheckoutDirectories = dirs
+}
+
 // self.refreshStatus is called at the end of this because that's when we can
 // be sure there is a State.Model.Branches array to pick the current branch from
 func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSelectionIndex bool) {
@@ -438,7 +524,7 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 		// which allows us to order them correctly. So if we're filtering we'll just
 		// manually load all the reflog commits here
 		var err error
-		reflogCommits, _, err = self.c.Git().Loaders.ReflogCommitLoader.GetReflogCommits(nil, "")
+		reflogCommits, _, err = self.c.Git().Loaders.ReflogCommitLoader.GetReflogCommits(nil, "", git_commands.LogFilter{})
 		if err != nil {
 			self.c.Log.Error(err)
 		}
@@ -493,6 +579,10 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 		return err
 	}
 
+	// The files need the sparse checkout directories to tell which of them are
+	// outside of the sparse checkout, so we load those first
+	self.refreshStateSparseCheckout()
+
 	if err := self.refreshStateFiles(); err != nil {
 		return err
 	}
@@ -502,6 +592,10 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 			self.c.Log.Error(err)
 		}
 
+		if err := self.refreshView(self.c.Contexts().SparseCheckout); err != nil {
+			self.c.Log.Error(err)
+		}
+
 		if err := self.refreshView(self.c.Contexts().Files); err != nil {
 			self.c.Log.Error(err)
 		}
@@ -548,6 +642,13 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	files := self.c.Git().Loaders.FileLoader.
 		GetStatusFiles(git_commands.GetStatusFileOptions{})
 
+	if self.c.Model().IsSparseCheckout {
+		dirs := self.c.Model().SparseCheckoutDirectories
+		for _, file := range files {
+			file.IsOutsideSparseCheckout = !models.IsDirInSparseCheckoutCone(path.Dir(file.Name), dirs)
+		}
+	}
+
 	conflictFileCount := 0
 	for _, file := range files {
 		if file.HasMergeConflicts {
@@ -597,9 +698,9 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 		lastReflogCommit = model.ReflogCommits[0]
 	}
 
-	refresh := func(stateCommits *[]*models.Commit, filterPath string) error {
+	refresh := func(stateCommits *[]*models.Commit, lastReflogCommit *models.Commit, filterPath string, filter git_commands.LogFilter) error {
 		commits, onlyObtainedNewReflogCommits, err := self.c.Git().Loaders.ReflogCommitLoader.
-			GetReflogCommits(lastReflogCommit, filterPath)
+			GetReflogCommits(lastReflogCommit, filterPath, filter)
 		if err != nil {
 			return self.c.Error(err)
 		}
@@ -612,12 +713,15 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 		return nil
 	}
 
-	if err := refresh(&model.ReflogCommits, ""); err != nil {
+	if err := refresh(&model.ReflogCommits, lastReflogCommit, "", git_commands.LogFilter{}); err != nil {
 		return err
 	}
 
 	if self.c.Modes().Filtering.Active() {
-		if err := refresh(&model.FilteredReflogCommits, self.c.Modes().Filtering.GetPath()); err != nil {
+		// The filtered reflog commits may have been loaded with different
+		// filter criteria than the current ones, or may still be the unfiltered
+		// ones, so we can't just load the new entries on top of them.
+		if err := refresh(&model.FilteredReflogCommits, nil, self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetLogFilter()); err != nil {
 			return err
 		}
 	} else {
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type RerereHelper struct {
+	c *HelperCommon
+}
+
+func NewRerereHelper(c *HelperCommon) *RerereHelper {
+	return &RerereHelper{
+		c: c,
+	}
+}
+
+// When rerere has resolved a file's conflicts from a recorded resolution there
+// is nothing left to pick in the merge conflicts view, so instead we tell the
+// user what happened and let them either accept the result or redo the
+// resolution themselves.
+func (self *RerereHelper) ShowResolvedByRerereNotice(path string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ResolvedByRerereTitle, map[string]string{"path": path}),
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.StageRerereResolution,
+				Tooltip: self.c.Tr.StageRerereResolutionTooltip,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.StageFile)
+					if err := self.c.Git().WorkingTree.StageFile(path); err != nil {
+						return self.c.Error(err)
+					}
+
+					return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+				},
+				Key: 's',
+			},
+			{
+				Label:   self.c.Tr.ForgetRerereResolution,
+				Tooltip: self.c.Tr.ForgetRerereResolutionTooltip,
+				OnPress: func() error {
+					return self.Forget(path)
+				},
+				Key: 'f',
+			},
+		},
+	})
+}
+
+func (self *RerereHelper) Forget(path string) error {
+	self.c.LogAction(self.c.Tr.Actions.ForgetRerereResolution)
+	if err := self.c.Git().Rerere.Forget(path); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+// Shows the contents of the rerere cache. Git doesn't record which file a
+// conflict happened in, so each entry shows the first line of the conflict to
+// help recognise it, and selecting it shows how the conflict was resolved.
+func (self *RerereHelper) ViewResolutions(parentContext types.Context) error {
+	resolutions, err := self.c.Git().Rerere.GetResolutions()
+	if err != nil {
+		return err
+	}
+
+	if len(resolutions) == 0 {
+		self.c.Toast(self.c.Tr.NoRerereResolutions)
+		return nil
+	}
+
+	resolutionsContext := self.c.Contexts().RerereResolutions
+	resolutionsContext.SetResolutions(resolutions)
+	resolutionsContext.SetSelection(0)
+	resolutionsContext.SetParentContext(parentContext)
+	resolutionsContext.GetView().TitlePrefix = parentContext.GetView().TitlePrefix
+
+	if err := self.c.PostRefreshUpdate(resolutionsContext); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(resolutionsContext)
+}
+
+func (self *RerereHelper) RefreshResolutions() error {
+	resolutions, err := self.c.Git().Rerere.GetResolutions()
+	if err != nil {
+		return err
+	}
+
+	resolutionsContext := self.c.Contexts().RerereResolutions
+	resolutionsContext.SetResolutions(resolutions)
+	resolutionsContext.ClampSelection()
+
+	return self.c.PostRefreshUpdate(resolutionsContext)
+}
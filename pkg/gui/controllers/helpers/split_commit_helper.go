@@ -0,0 +1,178 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Splitting a commit works by stopping at it in an interactive rebase and
+// showing its files, so that the user can pick the changes for the first new
+// commit with the custom patch builder. Each time they commit a part, it's
+// taken out of the commit at HEAD, leaving a commit with the rest of the
+// changes on top of it, and we show that one's files. Once nothing is left, we
+// continue the rebase.
+type SplitCommitHelper struct {
+	c                    *HelperCommon
+	commitsHelper        *CommitsHelper
+	mergeAndRebaseHelper *MergeAndRebaseHelper
+}
+
+func NewSplitCommitHelper(
+	c *HelperCommon,
+	commitsHelper *CommitsHelper,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
+) *SplitCommitHelper {
+	return &SplitCommitHelper{
+		c:                    c,
+		commitsHelper:        commitsHelper,
+		mergeAndRebaseHelper: mergeAndRebaseHelper,
+	}
+}
+
+func (self *SplitCommitHelper) IsSplitting() bool {
+	return self.c.Modes().SplittingCommit.Active() &&
+		self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_REBASING
+}
+
+func (self *SplitCommitHelper) StartSplit(commits []*models.Commit, commitIndex int) error {
+	commit := commits[commitIndex]
+	self.c.Git().Patch.PatchBuilder.Reset()
+
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.SplitCommit)
+		err := self.c.Git().Rebase.BeginInteractiveRebaseForCommit(commits, commitIndex, false)
+		if err != nil {
+			return self.mergeAndRebaseHelper.CheckMergeOrRebase(err)
+		}
+
+		self.c.Modes().SplittingCommit.SetSha(commit.Sha)
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(self.showHeadCommitFiles)
+		return nil
+	})
+}
+
+// Returns the commit we're stopped at, which is what's left of the commit
+// being split
+func (self *SplitCommitHelper) headCommit() (*models.Commit, int, bool) {
+	return lo.FindIndexOf(self.c.Model().Commits, func(c *models.Commit) bool {
+		return !c.IsTODO()
+	})
+}
+
+// Shows the files of what's left of the commit being split
+func (self *SplitCommitHelper) showHeadCommitFiles() error {
+	headCommit, headIdx, ok := self.headCommit()
+	if !ok {
+		return nil
+	}
+
+	localCommitsContext := self.c.Contexts().LocalCommits
+	localCommitsContext.SetSelection(headIdx)
+
+	commitFilesContext := self.c.Contexts().CommitFiles
+	commitFilesContext.SetSelection(0)
+	commitFilesContext.SetRef(headCommit)
+	commitFilesContext.SetTitleRef(headCommit.Description())
+	commitFilesContext.SetCanRebase(false)
+	commitFilesContext.SetParentContext(localCommitsContext)
+	commitFilesContext.SetWindowName(localCommitsContext.GetWindowName())
+	commitFilesContext.ClearSearchString()
+	commitFilesContext.GetView().TitlePrefix = localCommitsContext.GetView().TitlePrefix
+
+	if err := self.c.Refresh(types.RefreshOptions{
+		Scope: []types.RefreshableView{types.COMMIT_FILES},
+	}); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(commitFilesContext)
+}
+
+func (self *SplitCommitHelper) CommitPartDisabledReason() *types.DisabledReason {
+	if !self.IsSplitting() {
+		return &types.DisabledReason{Text: self.c.Tr.NotSplittingCommit}
+	}
+
+	// The part is taken out of the commit at HEAD, so a patch built from any
+	// other commit's files would be applied to the wrong changes
+	patchBuilder := self.c.Git().Patch.PatchBuilder
+	headCommit, _, ok := self.headCommit()
+	if !patchBuilder.Active() || patchBuilder.IsEmpty() || !ok || patchBuilder.To != headCommit.Sha {
+		return &types.DisabledReason{Text: self.c.Tr.SplitPartNotInPatch}
+	}
+
+	return nil
+}
+
+// Opens the commit message panel for a commit with the changes that are in the
+// custom patch, pre-filled with the message of the commit being split
+func (self *SplitCommitHelper) CommitPart() error {
+	if self.c.CurrentContext().GetKey() == self.c.Contexts().CustomPatchBuilder.GetKey() {
+		if err := self.c.PopContext(); err != nil {
+			return err
+		}
+	}
+
+	commitMessage, err := self.c.Git().Commit.GetCommitMessage("HEAD")
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.commitsHelper.OpenCommitMessagePanel(
+		&OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   commitMessage,
+			SummaryTitle:     self.c.Tr.SplitCommitPartTitle,
+			DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm:        self.commitPart,
+		},
+	)
+}
+
+func (self *SplitCommitHelper) commitPart(summary string, description string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.SplittingCommitStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CommitSplitPart)
+		somethingLeft, err := self.c.Git().Patch.SplitPatchOffHeadCommit(summary, description)
+		if err != nil {
+			return err
+		}
+
+		self.c.Git().Patch.PatchBuilder.Reset()
+
+		if !somethingLeft {
+			self.c.OnUIThread(self.continueRebase)
+			return nil
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(self.showHeadCommitFiles)
+		return nil
+	})
+}
+
+// Leaves whatever hasn't been split off yet in a commit of its own, and carries
+// on with the rebase
+func (self *SplitCommitHelper) FinishSplit() error {
+	self.c.Git().Patch.PatchBuilder.Reset()
+	return self.continueRebase()
+}
+
+func (self *SplitCommitHelper) continueRebase() error {
+	if err := self.c.PushContext(self.c.Contexts().LocalCommits); err != nil {
+		return err
+	}
+
+	return self.mergeAndRebaseHelper.genericMergeCommand(REBASE_OPTION_CONTINUE)
+}
@@ -1,13 +1,21 @@
 package helpers
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type StagingHelper struct {
 	c *HelperCommon
+
+	// While we're stepping through the hunks of all files with unstaged
+	// changes one file after another, these are the files we've been through
+	// so far. Nil when we're just staging a single file.
+	hunkWalkthroughVisitedPaths *set.Set[string]
 }
 
 func NewStagingHelper(
@@ -61,9 +69,13 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) erro
 	mainContext.GetMutex().Lock()
 	secondaryContext.GetMutex().Lock()
 
+	isNewFile := mainContext.GetState() == nil
 	mainContext.SetState(
 		patch_exploring.NewState(mainDiff, mainSelectedLineIdx, mainContext.GetState(), self.c.Log),
 	)
+	if self.IsWalkingThroughHunks() && isNewFile && mainContext.GetState() != nil {
+		mainContext.GetState().SetHunkSelectMode()
+	}
 
 	secondaryContext.SetState(
 		patch_exploring.NewState(secondaryDiff, secondarySelectedLineIdx, secondaryContext.GetState(), self.c.Log),
@@ -78,6 +90,10 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) erro
 	mainContext.GetMutex().Unlock()
 	secondaryContext.GetMutex().Unlock()
 
+	if mainState == nil && self.IsWalkingThroughHunks() {
+		return self.WalkToNextFile()
+	}
+
 	if mainState == nil && secondaryState == nil {
 		return self.handleStagingEscape()
 	}
@@ -109,6 +125,84 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) erro
 	})
 }
 
+// Steps through the hunks of every file with unstaged changes in turn, like
+// `git add -p`, starting with the first file
+func (self *StagingHelper) StartHunkWalkthrough() error {
+	files := self.HunkWalkthroughFiles()
+	if len(files) == 0 {
+		return nil
+	}
+
+	self.hunkWalkthroughVisitedPaths = set.New[string]()
+	return self.walkThroughFile(files[0])
+}
+
+func (self *StagingHelper) EndHunkWalkthrough() {
+	self.hunkWalkthroughVisitedPaths = nil
+}
+
+func (self *StagingHelper) IsWalkingThroughHunks() bool {
+	return self.hunkWalkthroughVisitedPaths != nil
+}
+
+// Moves on to the next file with unstaged changes that we haven't been through
+// yet, or back to the files panel if there are none left
+func (self *StagingHelper) WalkToNextFile() error {
+	nextFile, found := lo.Find(self.HunkWalkthroughFiles(), func(file *models.File) bool {
+		return !self.hunkWalkthroughVisitedPaths.Includes(file.Name)
+	})
+
+	if !found {
+		self.EndHunkWalkthrough()
+		self.c.Toast(self.c.Tr.NoMoreHunksToStage)
+		return self.handleStagingEscape()
+	}
+
+	return self.walkThroughFile(nextFile)
+}
+
+// Returns the files we step through when staging hunks one by one, in the
+// order they're shown in. Like `git add -p`, we leave out untracked files, and
+// we can't stage parts of conflicted files or submodules.
+func (self *StagingHelper) HunkWalkthroughFiles() []*models.File {
+	root := self.c.Contexts().Files.GetRoot()
+	if root == nil {
+		return nil
+	}
+
+	return lo.FilterMap(root.GetLeaves(), func(node *filetree.Node[models.File], _ int) (*models.File, bool) {
+		file := node.File
+		return file, file != nil && file.HasUnstagedChanges && file.Tracked && !file.HasMergeConflicts &&
+			!file.IsSubmodule(self.c.Model().Submodules)
+	})
+}
+
+func (self *StagingHelper) walkThroughFile(file *models.File) error {
+	self.hunkWalkthroughVisitedPaths.Add(file.Name)
+
+	filesContext := self.c.Contexts().Files
+	filesContext.ExpandToPath(file.Name)
+	index, found := filesContext.GetIndexForPath(file.Name)
+	if !found {
+		return nil
+	}
+	filesContext.SetSelection(index)
+	filesContext.FocusLine()
+	if err := self.c.PostRefreshUpdate(filesContext); err != nil {
+		return err
+	}
+
+	// start afresh rather than carrying over the selection of the previous file
+	self.c.Contexts().Staging.SetState(nil)
+	self.c.Contexts().StagingSecondary.SetState(nil)
+
+	if self.mainStagingFocused() {
+		return self.RefreshStagingPanel(types.OnFocusOpts{})
+	}
+
+	return self.c.PushContext(self.c.Contexts().Staging)
+}
+
 func (self *StagingHelper) handleStagingEscape() error {
 	return self.c.PushContext(self.c.Contexts().Files)
 }
@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"fmt"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -32,6 +34,8 @@ type ViewSubCommitsOpts struct {
 	TitleRef                string
 	Context                 types.Context
 	ShowBranchHeads         bool
+	// see GetCommitsOptions.FilterLineRange
+	FilterLineRange string
 }
 
 func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
@@ -39,10 +43,12 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 		git_commands.GetCommitsOptions{
 			Limit:                   true,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
+			Filter:                  self.c.Modes().Filtering.GetLogFilter(),
 			IncludeRebaseCommits:    false,
 			RefName:                 opts.Ref.FullRefName(),
 			RefForPushedStatus:      opts.Ref.FullRefName(),
 			RefToShowDivergenceFrom: opts.RefToShowDivergenceFrom,
+			FilterLineRange:         opts.FilterLineRange,
 		},
 	)
 	if err != nil {
@@ -59,6 +65,7 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 	subCommitsContext.SetTitleRef(utils.TruncateWithEllipsis(opts.TitleRef, 50))
 	subCommitsContext.SetRef(opts.Ref)
 	subCommitsContext.SetRefToShowDivergenceFrom(opts.RefToShowDivergenceFrom)
+	subCommitsContext.SetFilterLineRange(opts.FilterLineRange)
 	subCommitsContext.SetLimitCommits(true)
 	subCommitsContext.SetShowBranchHeads(opts.ShowBranchHeads)
 	subCommitsContext.ClearSearchString()
@@ -72,3 +79,15 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 
 	return self.c.PushContext(self.c.Contexts().SubCommits)
 }
+
+// Shows the commits that touched the given range of lines of a file, as the
+// file is in ref
+func (self *SubCommitsHelper) ViewLineRangeHistory(ref types.Ref, path string, startLine int, endLine int, context types.Context) error {
+	return self.ViewSubCommits(ViewSubCommitsOpts{
+		Ref:             ref,
+		TitleRef:        fmt.Sprintf("%s:%d-%d", path, startLine, endLine),
+		Context:         context,
+		ShowBranchHeads: false,
+		FilterLineRange: fmt.Sprintf("%d,%d:%s", startLine, endLine, path),
+	})
+}
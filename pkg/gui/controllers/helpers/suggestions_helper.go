@@ -197,3 +197,14 @@ func FuzzySearchFunc(options []string) func(string) []*types.Suggestion {
 		return matchesToSuggestions(matches)
 	}
 }
+
+// Unlike the other suggestion functions this asks git for the directories,
+// because the ones outside of the sparse checkout aren't in the working tree
+func (self *SuggestionsHelper) GetSparseCheckoutDirectorySuggestionsFunc() func(string) []*types.Suggestion {
+	dirs, err := self.c.Git().SparseCheckout.GetAllDirectories()
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	return FuzzySearchFunc(dirs)
+}
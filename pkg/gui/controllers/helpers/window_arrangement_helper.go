@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
@@ -184,6 +185,9 @@ func mainPanelChildren(args WindowArrangementArgs) []*boxlayout.Box {
 			Weight:    1,
 		},
 	}
+	if isMergeEditorWindow(args.CurrentStaticWindow) {
+		result = []*boxlayout.Box{mergeEditorBox()}
+	}
 	if args.ShowExtrasWindow {
 		result = append(result, &boxlayout.Box{
 			Window: "extras",
@@ -228,6 +232,34 @@ func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	}
 }
 
+// The merge editor shows the versions of a conflict next to each other, with
+// the result underneath
+func mergeEditorBox() *boxlayout.Box {
+	return &boxlayout.Box{
+		Direction: boxlayout.ROW,
+		Weight:    1,
+		Children: []*boxlayout.Box{
+			{
+				Direction: boxlayout.COLUMN,
+				Weight:    1,
+				Children: []*boxlayout.Box{
+					{Window: "mergeEditorOurs", Weight: 1},
+					{Window: "mergeEditorBase", Weight: 1},
+					{Window: "mergeEditorTheirs", Weight: 1},
+				},
+			},
+			{
+				Window: "mergeEditorResult",
+				Weight: 1,
+			},
+		},
+	}
+}
+
+func isMergeEditorWindow(window string) bool {
+	return lo.Contains([]string{"mergeEditorOurs", "mergeEditorBase", "mergeEditorTheirs", "mergeEditorResult"}, window)
+}
+
 func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 	// we originally specified this as a ratio i.e. .20 would correspond to a weight of 1 against 4
 	sidePanelWidthRatio := args.UserConfig.Gui.SidePanelWidth
@@ -239,7 +271,10 @@ func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 		mainSectionWeight = 5 // need to shrink side panel to make way for main panels if side-by-side
 	}
 
-	if args.CurrentWindow == "main" {
+	if isMergeEditorWindow(args.CurrentStaticWindow) {
+		// the merge editor needs all the room it can get
+		sideSectionWeight = 0
+	} else if args.CurrentWindow == "main" {
 		if args.ScreenMode == types.SCREEN_HALF || args.ScreenMode == types.SCREEN_FULL {
 			sideSectionWeight = 0
 		}
@@ -153,6 +153,38 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "merge editor",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20
+				args.CurrentWindow = "mergeEditorOurs"
+				args.CurrentStaticWindow = "mergeEditorOurs"
+			},
+			expected: `
+			╭mergeEditorOurs────────╮╭mergeEditorBase────────╮╭mergeEditorTheirs──────╮
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			│                       ││                       ││                       │
+			╰───────────────────────╯╰───────────────────────╯╰───────────────────────╯
+			╭mergeEditorResult────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
 		{
 			name: "half screen mode, enlargedSideViewLocation top",
 			mutateArgs: func(args *WindowArrangementArgs) {
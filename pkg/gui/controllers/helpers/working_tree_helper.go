@@ -22,6 +22,7 @@ type WorkingTreeHelper struct {
 	refHelper     *RefsHelper
 	commitsHelper *CommitsHelper
 	gpgHelper     *GpgHelper
+	lfsHelper     *LfsHelper
 }
 
 func NewWorkingTreeHelper(
@@ -29,12 +30,14 @@ func NewWorkingTreeHelper(
 	refHelper *RefsHelper,
 	commitsHelper *CommitsHelper,
 	gpgHelper *GpgHelper,
+	lfsHelper *LfsHelper,
 ) *WorkingTreeHelper {
 	return &WorkingTreeHelper{
 		c:             c,
 		refHelper:     refHelper,
 		commitsHelper: commitsHelper,
 		gpgHelper:     gpgHelper,
+		lfsHelper:     lfsHelper,
 	}
 }
 
@@ -85,20 +88,24 @@ func (self *WorkingTreeHelper) OpenMergeTool() error {
 
 func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage string) error {
 	return self.WithEnsureCommitableFiles(func() error {
-		return self.commitsHelper.OpenCommitMessagePanel(
-			&OpenCommitMessagePanelOpts{
-				CommitIndex:      context.NoCommitIndex,
-				InitialMessage:   initialMessage,
-				SummaryTitle:     self.c.Tr.CommitSummaryTitle,
-				DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
-				PreserveMessage:  true,
-				OnConfirm:        self.handleCommit,
-				OnSwitchToEditor: self.switchFromCommitMessagePanelToEditor,
-			},
-		)
+		return self.openCommitMessagePanel(initialMessage)
 	})
 }
 
+func (self *WorkingTreeHelper) openCommitMessagePanel(initialMessage string) error {
+	return self.commitsHelper.OpenCommitMessagePanel(
+		&OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   initialMessage,
+			SummaryTitle:     self.c.Tr.CommitSummaryTitle,
+			DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
+			PreserveMessage:  true,
+			OnConfirm:        self.handleCommit,
+			OnSwitchToEditor: self.switchFromCommitMessagePanelToEditor,
+		},
+	)
+}
+
 func (self *WorkingTreeHelper) handleCommit(summary string, description string) error {
 	cmdObj := self.c.Git().Commit.CommitCmdObj(summary, description)
 	self.c.LogAction(self.c.Tr.Actions.Commit)
@@ -145,22 +152,36 @@ func (self *WorkingTreeHelper) HandleWIPCommitPress() error {
 
 func (self *WorkingTreeHelper) HandleCommitPress() error {
 	message := self.c.Contexts().CommitMessage.GetPreservedMessage()
+	if message != "" {
+		return self.HandleCommitPressWithMessage(message)
+	}
 
-	if message == "" {
-		commitPrefixConfig := self.commitPrefixConfigForRepo()
-		if commitPrefixConfig != nil {
-			prefixPattern := commitPrefixConfig.Pattern
-			prefixReplace := commitPrefixConfig.Replace
-			rgx, err := regexp.Compile(prefixPattern)
-			if err != nil {
-				return self.c.ErrorMsg(fmt.Sprintf("%s: %s", self.c.Tr.CommitPrefixPatternError, err.Error()))
-			}
-			prefix := rgx.ReplaceAllString(self.refHelper.GetCheckedOutRef().Name, prefixReplace)
-			message = prefix
+	prefix := ""
+	commitPrefixConfig := self.commitPrefixConfigForRepo()
+	if commitPrefixConfig != nil {
+		prefixPattern := commitPrefixConfig.Pattern
+		prefixReplace := commitPrefixConfig.Replace
+		rgx, err := regexp.Compile(prefixPattern)
+		if err != nil {
+			return self.c.ErrorMsg(fmt.Sprintf("%s: %s", self.c.Tr.CommitPrefixPatternError, err.Error()))
 		}
+		prefix = rgx.ReplaceAllString(self.refHelper.GetCheckedOutRef().Name, prefixReplace)
 	}
 
-	return self.HandleCommitPressWithMessage(message)
+	template, err := self.c.Git().Commit.GetCommitTemplate()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if !self.c.UserConfig.Git.Commit.ConventionalCommits {
+		return self.HandleCommitPressWithMessage(prefix + template)
+	}
+
+	return self.WithEnsureCommitableFiles(func() error {
+		return self.commitsHelper.PromptForConventionalCommitPrefix(func(conventionalPrefix string) error {
+			return self.openCommitMessagePanel(conventionalPrefix + prefix + template)
+		})
+	})
 }
 
 func (self *WorkingTreeHelper) WithEnsureCommitableFiles(handler func() error) error {
@@ -173,10 +194,12 @@ func (self *WorkingTreeHelper) WithEnsureCommitableFiles(handler func() error) e
 	}
 
 	if !self.AnyStagedFiles() {
-		return self.promptToStageAllAndRetry(handler)
+		return self.promptToStageAllAndRetry(func() error {
+			return self.lfsHelper.WithLargeFileCheck(handler)
+		})
 	}
 
-	return handler()
+	return self.lfsHelper.WithLargeFileCheck(handler)
 }
 
 func (self *WorkingTreeHelper) promptToStageAllAndRetry(retry func() error) error {
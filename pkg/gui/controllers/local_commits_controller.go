@@ -2,15 +2,18 @@ package controllers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -146,6 +149,37 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			),
 			Description: self.c.Tr.SquashAboveCommits,
 		},
+		{
+			Key:     opts.GetKey(opts.Config.Commits.SplitCommit),
+			Handler: self.withItem(self.splitCommit),
+			GetDisabledReason: self.require(
+				self.notMidRebase(self.c.Tr.AlreadyRebasing),
+				self.singleItemSelected(self.canSplitCommit),
+			),
+			Description: self.c.Tr.SplitCommit,
+			Tooltip: utils.ResolvePlaceholderString(self.c.Tr.SplitCommitTooltip, map[string]string{
+				"commitKey": keybindings.Label(opts.Config.CommitFiles.CommitSplitPart),
+				"resetKey":  keybindings.Label(opts.Config.Universal.Return),
+			}),
+		},
+		{
+			Key:     opts.GetKey(opts.Config.Commits.MoveCommitsToBranch),
+			Handler: self.withItemsRange(self.moveCommitsToBranch),
+			GetDisabledReason: self.require(
+				self.notMidRebase(self.c.Tr.AlreadyRebasing),
+				self.itemRangeSelected(self.canMoveCommitsToBranch),
+			),
+			Description: self.c.Tr.MoveCommitsToBranch,
+			Tooltip:     self.c.Tr.MoveCommitsToBranchTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.OpenBranchStackMenu),
+			Handler:     self.c.Helpers().BranchStack.OpenMenu,
+			Description: self.c.Tr.BranchStack,
+			Tooltip:     self.c.Tr.BranchStackTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveDownCommit),
 			Handler: self.withItemsRange(self.moveDown),
@@ -250,7 +284,7 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 						}))
 			} else {
 				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), self.signatureSummary(commit.Signature))
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -266,6 +300,34 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 	}
 }
 
+// Describes the commit's signature, if we've verified it and there is one, for
+// showing above the commit's patch
+func (self *LocalCommitsController) signatureSummary(signature *models.CommitSignature) string {
+	if signature == nil || signature.Status == models.SignatureStatusNone {
+		return ""
+	}
+
+	var status string
+	switch signature.Status {
+	case models.SignatureStatusGood:
+		status = style.FgGreen.Sprint(self.c.Tr.GoodSignature)
+	case models.SignatureStatusBad:
+		status = style.FgRed.Sprint(self.c.Tr.BadSignature)
+	default:
+		status = style.FgYellow.Sprint(self.c.Tr.UnknownSignature)
+	}
+
+	lines := []string{status}
+	if signature.Signer != "" {
+		lines = append(lines, fmt.Sprintf("%s: %s", self.c.Tr.SignatureSigner, signature.Signer))
+	}
+	if signature.Key != "" {
+		lines = append(lines, fmt.Sprintf("%s: %s", self.c.Tr.SignatureKey, signature.Key))
+	}
+
+	return strings.Join(lines, "\n") + "\n\n"
+}
+
 func secondaryPatchPanelUpdateOpts(c *ControllerCommon) *types.ViewUpdateOpts {
 	if c.Git().Patch.PatchBuilder.Active() {
 		patch := c.Git().Patch.PatchBuilder.RenderAggregatedPatch(false)
@@ -793,6 +855,107 @@ func (self *LocalCommitsController) squashAllAboveFixupCommits(commit *models.Co
 	})
 }
 
+func (self *LocalCommitsController) splitCommit(commit *models.Commit) error {
+	return self.c.Helpers().SplitCommit.StartSplit(self.c.Model().Commits, self.context().GetSelectedLineIdx())
+}
+
+func (self *LocalCommitsController) canSplitCommit(commit *models.Commit) *types.DisabledReason {
+	if commit.IsFirstCommit() {
+		return &types.DisabledReason{Text: self.c.Tr.CantSplitFirstCommit}
+	}
+
+	commitsUpToSelected := self.c.Model().Commits[:self.context().GetSelectedLineIdx()+1]
+	if lo.SomeBy(commitsUpToSelected, func(c *models.Commit) bool { return c.IsMerge() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CantSplitMergeCommit}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) moveCommitsToBranch(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.MoveCommitsToBranchTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.MoveCommitsToNewBranch,
+				OnPress: func() error {
+					return self.moveCommitsToNewBranch(selectedCommits, startIdx, endIdx)
+				},
+				Key:     'n',
+				Tooltip: self.c.Tr.MoveCommitsToNewBranchTooltip,
+			},
+			{
+				Label: self.c.Tr.MoveCommitsToExistingBranch,
+				OnPress: func() error {
+					return self.moveCommitsToExistingBranch(startIdx, endIdx)
+				},
+				Key:     'e',
+				Tooltip: self.c.Tr.MoveCommitsToExistingBranchTooltip,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) moveCommitsToNewBranch(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.MoveCommitsNewBranchPrompt,
+		HandleConfirm: func(response string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.MovingCommitsStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.MoveCommitsToBranch)
+				// The newest selected commit has the others below it, so a branch
+				// pointing at it has them on top of the commit they're based on
+				branchName := helpers.SanitizedBranchName(response)
+				if err := self.c.Git().Branch.NewWithoutCheckout(branchName, selectedCommits[0].Sha); err != nil {
+					return err
+				}
+
+				return self.interactiveRebase(todo.Drop, startIdx, endIdx)
+			})
+		},
+	})
+}
+
+func (self *LocalCommitsController) moveCommitsToExistingBranch(startIdx int, endIdx int) error {
+	otherBranchNames := lo.FilterMap(self.c.Model().Branches, func(branch *models.Branch, _ int) (string, bool) {
+		return branch.Name, !branch.Head && !branch.DetachedHead
+	})
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.MoveCommitsExistingBranchPrompt,
+		FindSuggestionsFunc: helpers.FuzzySearchFunc(otherBranchNames),
+		HandleConfirm: func(branchName string) error {
+			branch, ok := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool {
+				return branch.Name == branchName && !branch.DetachedHead
+			})
+			placeholders := map[string]string{"branch": branchName}
+			if !ok {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.MoveCommitsBranchDoesNotExist, placeholders))
+			}
+			if branch.Head {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.MoveCommitsToCurrentBranch, placeholders))
+			}
+			if git_commands.CheckedOutByOtherWorktree(branch, self.c.Model().Worktrees) {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.MoveCommitsBranchCheckedOut, placeholders))
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.MovingCommitsStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.MoveCommitsToBranch)
+				self.context().SetSelection(startIdx)
+				err := self.c.Git().Rebase.MoveCommitsToBranch(self.c.Model().Commits, startIdx, endIdx, branchName)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+			})
+		},
+	})
+}
+
+func (self *LocalCommitsController) canMoveCommitsToBranch(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+	if lo.SomeBy(selectedCommits, func(commit *models.Commit) bool { return commit.IsMerge() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CantMoveMergeCommitsToBranch}
+	}
+
+	return nil
+}
+
 func (self *LocalCommitsController) createTag(commit *models.Commit) error {
 	return self.c.Helpers().Tags.OpenCreateTagPrompt(commit.Sha, func() {})
 }
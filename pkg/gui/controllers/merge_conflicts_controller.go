@@ -112,6 +112,19 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Description: self.c.Tr.PickAllHunks,
 			Display:     true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.OpenMergeEditor),
+			Handler:     self.withLock(self.c.Helpers().MergeEditor.Open),
+			Description: self.c.Tr.OpenMergeEditor,
+			Tooltip:     self.c.Tr.OpenMergeEditorTooltip,
+			Display:     true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ShowConflictBase),
+			Handler:     self.c.Helpers().MergeEditor.ShowConflictBase,
+			Description: self.c.Tr.ShowConflictBase,
+			Tooltip:     self.c.Tr.ShowConflictBaseTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type MergeEditorPaneController struct {
+	baseController
+	*ListControllerTrait[*context.MergeEditorLine]
+	c       *ControllerCommon
+	context *context.MergeEditorPaneContext
+}
+
+var _ types.IController = &MergeEditorPaneController{}
+
+func NewMergeEditorPaneController(
+	c *ControllerCommon,
+	paneContext *context.MergeEditorPaneContext,
+) *MergeEditorPaneController {
+	return &MergeEditorPaneController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*context.MergeEditorLine](
+			c,
+			paneContext,
+			paneContext.GetSelected,
+			paneContext.GetSelectedItems,
+		),
+		c:       c,
+		context: paneContext,
+	}
+}
+
+func (self *MergeEditorPaneController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Select),
+			Handler:           self.pick,
+			GetDisabledReason: self.require(self.itemsSelected()),
+			Description:       self.c.Tr.MergeEditorPickLines,
+			Tooltip:           self.c.Tr.MergeEditorPickLinesTooltip,
+			Display:           true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ConfirmInEditor),
+			Handler:     self.c.Helpers().MergeEditor.Apply,
+			Description: self.c.Tr.MergeEditorApply,
+			Tooltip:     self.c.Tr.MergeEditorApplyTooltip,
+			Display:     true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.TogglePanel),
+			Handler:     self.nextPane,
+			Description: self.c.Tr.MergeEditorNextPane,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ShowConflictBase),
+			Handler:     self.c.Helpers().MergeEditor.ShowConflictBase,
+			Description: self.c.Tr.ShowConflictBase,
+			Tooltip:     self.c.Tr.ShowConflictBaseTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Return),
+			Handler:     self.c.Helpers().MergeEditor.Close,
+			Description: self.c.Tr.MergeEditorClose,
+		},
+	}
+}
+
+func (self *MergeEditorPaneController) Context() types.Context {
+	return self.context
+}
+
+func (self *MergeEditorPaneController) pick() error {
+	return self.c.Helpers().MergeEditor.Pick(self.context)
+}
+
+func (self *MergeEditorPaneController) nextPane() error {
+	return self.c.PushContext(self.c.Helpers().MergeEditor.NextPane(self.context))
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type MergeEditorResultController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = &MergeEditorResultController{}
+
+func NewMergeEditorResultController(
+	c *ControllerCommon,
+) *MergeEditorResultController {
+	return &MergeEditorResultController{
+		baseController: baseController{},
+		c:              c,
+	}
+}
+
+func (self *MergeEditorResultController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	// the result view is editable, so only keys that don't insert text can be
+	// bound here
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ConfirmInEditor),
+			Handler:     self.c.Helpers().MergeEditor.Apply,
+			Description: self.c.Tr.MergeEditorApply,
+			Tooltip:     self.c.Tr.MergeEditorApplyTooltip,
+			Display:     true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.TogglePanel),
+			Handler:     self.nextPane,
+			Description: self.c.Tr.MergeEditorNextPane,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Return),
+			Handler:     self.c.Helpers().MergeEditor.Close,
+			Description: self.c.Tr.MergeEditorClose,
+		},
+	}
+}
+
+func (self *MergeEditorResultController) Context() types.Context {
+	return self.context()
+}
+
+func (self *MergeEditorResultController) context() *context.MergeEditorResultContext {
+	return self.c.Contexts().MergeEditorResult
+}
+
+func (self *MergeEditorResultController) nextPane() error {
+	return self.c.PushContext(self.c.Helpers().MergeEditor.NextPane(self.context()))
+}
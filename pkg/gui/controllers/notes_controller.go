@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type NotesController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+}
+
+var _ types.IController = &NotesController{}
+
+func NewNotesController(
+	c *ControllerCommon,
+) *NotesController {
+	return &NotesController{
+		baseController: baseController{},
+		c:              c,
+		ListControllerTrait: NewListControllerTrait[*models.Commit](
+			c,
+			c.Contexts().LocalCommits,
+			c.Contexts().LocalCommits.GetSelected,
+			c.Contexts().LocalCommits.GetSelectedItems,
+		),
+	}
+}
+
+func (self *NotesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Commits.OpenNotesMenu),
+			Handler:           self.withItem(self.openMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canAttachNote)),
+			Description:       self.c.Tr.OpenNotesMenu,
+			Tooltip:           self.c.Tr.OpenNotesMenuTooltip,
+			OpensMenu:         true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *NotesController) canAttachNote(commit *models.Commit) *types.DisabledReason {
+	if commit.IsTODO() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotAttachNoteToTodo}
+	}
+
+	return nil
+}
+
+func (self *NotesController) openMenu(commit *models.Commit) error {
+	notesRefs := self.c.Git().Notes.Refs()
+
+	menuItems := []*types.MenuItem{}
+	for _, notesRef := range notesRefs {
+		notesRef := notesRef
+		note := self.c.Git().Notes.Show(notesRef, commit.Sha)
+
+		var removeDisabledReason *types.DisabledReason
+		if note == "" {
+			removeDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoNoteToRemove}
+		}
+
+		editItem := &types.MenuItem{
+			Label: self.labelForRef(self.c.Tr.AddOrEditNote, notesRef),
+			OnPress: func() error {
+				return self.edit(notesRef, commit)
+			},
+		}
+		removeItem := &types.MenuItem{
+			Label: self.labelForRef(self.c.Tr.RemoveNote, notesRef),
+			OnPress: func() error {
+				return self.remove(notesRef, commit)
+			},
+			DisabledReason: removeDisabledReason,
+		}
+
+		// We only assign keys for the default notes ref, because there's no
+		// sensible way of picking distinct keys for an arbitrary number of refs
+		if notesRef == "" {
+			editItem.Key = 'e'
+			removeItem.Key = 'd'
+		}
+
+		menuItems = append(menuItems, editItem, removeItem)
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label:   self.c.Tr.PushNotes,
+			OnPress: self.push,
+			Key:     'P',
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.FetchNotes,
+			OnPress: self.fetch,
+			Key:     'f',
+		},
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.NotesMenuTitle,
+		Items: menuItems,
+	})
+}
+
+// The default notes ref is the one that most people use, so we leave it
+// unlabelled and only show the ref for the extra ones configured by the user
+func (self *NotesController) labelForRef(label string, notesRef string) string {
+	if notesRef == "" {
+		return label
+	}
+
+	return fmt.Sprintf("%s (%s)", label, notesRef)
+}
+
+func (self *NotesController) edit(notesRef string, commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.EditNote)
+	return self.c.RunSubprocessAndRefresh(
+		self.c.Git().Notes.EditCmdObj(notesRef, commit.Sha),
+	)
+}
+
+func (self *NotesController) remove(notesRef string, commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.RemoveNote)
+	if err := self.c.Git().Notes.Remove(notesRef, commit.Sha); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+}
+
+func (self *NotesController) push() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PushNotesTitle,
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.PushingNotesStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PushNotes)
+				return self.c.Git().Notes.Push(task, response)
+			})
+		},
+	})
+}
+
+func (self *NotesController) fetch() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.FetchNotesTitle,
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.FetchingNotesStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.FetchNotes)
+				if err := self.c.Git().Notes.Fetch(task, response); err != nil {
+					return err
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+			})
+		},
+	})
+}
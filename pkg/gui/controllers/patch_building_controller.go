@@ -39,6 +39,20 @@ func (self *PatchBuildingController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.ToggleSelectionAndRefresh,
 			Description: self.c.Tr.ToggleSelectionForPatch,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.ViewLineRangeHistory),
+			Handler:           self.ViewLineRangeHistory,
+			GetDisabledReason: self.canViewLineRangeHistory,
+			Description:       self.c.Tr.ViewLineRangeHistory,
+			Tooltip:           self.c.Tr.ViewLineRangeHistoryTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.CommitSplitPart),
+			Handler:           self.c.Helpers().SplitCommit.CommitPart,
+			GetDisabledReason: self.c.Helpers().SplitCommit.CommitPartDisabledReason,
+			Description:       self.c.Tr.CommitSplitPart,
+			Tooltip:           self.c.Tr.CommitSplitPartTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -107,6 +121,31 @@ func (self *PatchBuildingController) EditFile() error {
 	return self.c.Helpers().Files.EditFileAtLine(path, lineNumber)
 }
 
+func (self *PatchBuildingController) canViewLineRangeHistory() *types.DisabledReason {
+	file := self.c.Contexts().CommitFiles.GetSelectedFile()
+	if file != nil && file.Deleted() {
+		return &types.DisabledReason{Text: self.c.Tr.LineHistoryDeletedFile}
+	}
+
+	return nil
+}
+
+// The lines are taken from the new version of the file, i.e. the one in the
+// commit whose files we're looking at.
+func (self *PatchBuildingController) ViewLineRangeHistory() error {
+	self.context().GetMutex().Lock()
+	path := self.c.Contexts().CommitFiles.GetSelectedPath()
+	startLine, endLine := self.context().GetState().SelectedFileLineRange(false)
+	self.context().GetMutex().Unlock()
+
+	if path == "" {
+		return nil
+	}
+
+	return self.c.Helpers().SubCommits.ViewLineRangeHistory(
+		self.c.Contexts().CommitFiles.GetRef(), path, startLine, endLine, self.c.Contexts().CommitFiles)
+}
+
 func (self *PatchBuildingController) ToggleSelectionAndRefresh() error {
 	if err := self.toggleSelection(); err != nil {
 		return err
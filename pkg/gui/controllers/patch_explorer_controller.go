@@ -96,6 +96,13 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.withRenderAndFocus(self.HandleToggleSelectHunk),
 			Description: self.c.Tr.ToggleSelectHunk,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.SplitHunk),
+			Handler:           self.withRenderAndFocus(self.HandleSplitHunk),
+			GetDisabledReason: self.canSplitHunk,
+			Description:       self.c.Tr.SplitHunk,
+			Tooltip:           self.c.Tr.SplitHunkTooltip,
+		},
 		{
 			Tag:         "navigation",
 			Key:         opts.GetKey(opts.Config.Universal.PrevPage),
@@ -229,6 +236,23 @@ func (self *PatchExplorerController) HandleToggleSelectHunk() error {
 	return nil
 }
 
+func (self *PatchExplorerController) canSplitHunk() *types.DisabledReason {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	if state := self.context.GetState(); state != nil && !state.CanSplitHunk() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotSplitHunk}
+	}
+
+	return nil
+}
+
+func (self *PatchExplorerController) HandleSplitHunk() error {
+	self.context.GetState().SplitHunk()
+
+	return nil
+}
+
 func (self *PatchExplorerController) HandleScrollLeft() error {
 	self.context.GetViewTrait().ScrollLeft()
 
@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Exports commits as patch files (git format-patch) and applies patch files
+// as new commits (git am)
+type PatchFilesController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+}
+
+var _ types.IController = &PatchFilesController{}
+
+func NewPatchFilesController(
+	c *ControllerCommon,
+) *PatchFilesController {
+	return &PatchFilesController{
+		baseController: baseController{},
+		c:              c,
+		ListControllerTrait: NewListControllerTrait[*models.Commit](
+			c,
+			c.Contexts().LocalCommits,
+			c.Contexts().LocalCommits.GetSelected,
+			c.Contexts().LocalCommits.GetSelectedItems,
+		),
+	}
+}
+
+func (self *PatchFilesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.OpenPatchFilesMenu),
+			Handler:     self.openMenu,
+			Description: self.c.Tr.OpenPatchFilesMenu,
+			Tooltip:     self.c.Tr.OpenPatchFilesMenuTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *PatchFilesController) openMenu() error {
+	commits, _, _ := self.context().GetSelectedItems()
+	exportDisabledReason := self.canExport(commits)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PatchFilesMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ExportPatchesToDirectory,
+				OnPress: func() error {
+					return self.exportToDirectory(commits)
+				},
+				Key:            'd',
+				DisabledReason: exportDisabledReason,
+			},
+			{
+				Label: self.c.Tr.CopyPatchesAsMbox,
+				OnPress: func() error {
+					return self.copyAsMbox(commits)
+				},
+				Key:            'c',
+				DisabledReason: exportDisabledReason,
+			},
+			{
+				Label:          self.c.Tr.ApplyPatchesWithAm,
+				Tooltip:        self.c.Tr.ApplyPatchesWithAmTooltip,
+				OnPress:        self.applyMailbox,
+				Key:            'a',
+				DisabledReason: self.canApply(),
+			},
+		},
+	})
+}
+
+func (self *PatchFilesController) canExport(commits []*models.Commit) *types.DisabledReason {
+	if len(commits) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+	}
+
+	if lo.SomeBy(commits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CannotExportTodoCommits}
+	}
+
+	return nil
+}
+
+func (self *PatchFilesController) canApply() *types.DisabledReason {
+	if self.c.Git().Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return &types.DisabledReason{Text: self.c.Tr.CannotApplyPatchesMidOperation}
+	}
+
+	return nil
+}
+
+// the selected commits are ordered from newest to oldest
+func (self *PatchFilesController) exportToDirectory(commits []*models.Commit) error {
+	oldest, newest := commits[len(commits)-1], commits[0]
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ExportPatchesDirectoryTitle,
+		HandleConfirm: func(dir string) error {
+			dir = strings.TrimSpace(dir)
+			if dir == "" {
+				return self.c.ErrorMsg(self.c.Tr.DirectoryRequired)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.ExportingPatchesStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ExportPatches)
+				paths, err := self.c.Git().Mailbox.FormatPatchToDir(oldest, newest, dir)
+				if err != nil {
+					return err
+				}
+
+				self.c.Toast(fmt.Sprintf(self.c.Tr.PatchesExported, len(paths), dir))
+				return nil
+			})
+		},
+	})
+}
+
+func (self *PatchFilesController) copyAsMbox(commits []*models.Commit) error {
+	oldest, newest := commits[len(commits)-1], commits[0]
+
+	mbox, err := self.c.Git().Mailbox.FormatPatchToMbox(oldest, newest)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CopyPatchesToClipboard)
+	if err := self.c.OS().CopyToClipboard(mbox); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.PatchesCopiedToClipboard)
+	return nil
+}
+
+func (self *PatchFilesController) applyMailbox() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ApplyPatchesPathTitle,
+		HandleConfirm: func(path string) error {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				return self.c.ErrorMsg(self.c.Tr.PatchFilePathRequired)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.ApplyingPatchesStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ApplyPatches)
+				err := self.c.Git().Mailbox.ApplyMailbox(path)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+			})
+		},
+	})
+}
+
+func (self *PatchFilesController) context() *context.LocalCommitsContext {
+	return self.c.Contexts().LocalCommits
+}
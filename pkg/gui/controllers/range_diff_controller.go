@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffController struct {
+	baseController
+	*ListControllerTrait[*models.RangeDiffPair]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RangeDiffController{}
+
+func NewRangeDiffController(
+	c *ControllerCommon,
+) *RangeDiffController {
+	return &RangeDiffController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.RangeDiffPair](
+			c,
+			c.Contexts().RangeDiff,
+			c.Contexts().RangeDiff.GetSelected,
+			c.Contexts().RangeDiff.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *RangeDiffController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{}
+}
+
+func (self *RangeDiffController) GetOnRenderToMain() func() error {
+	return func() error {
+		var task types.UpdateTask
+		pair := self.context().GetSelected()
+		if pair == nil {
+			task = types.NewRenderStringTask(self.c.Tr.NoCommitsThisBranch)
+		} else {
+			task = self.showPairTask(pair)
+		}
+
+		return self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.RangeDiffTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+// For commits that changed we show the interdiff; for all others the interdiff
+// would be empty, so we show the commit itself instead. The interdiff is a diff
+// of diffs, which pagers like delta don't understand, so we don't run it in a pty.
+func (self *RangeDiffController) showPairTask(pair *models.RangeDiffPair) types.UpdateTask {
+	switch pair.Status {
+	case models.RangeDiffStatusModified:
+		return types.NewRunCommandTask(self.c.Git().RangeDiff.ShowPairCmdObj(pair).GetCmd())
+	case models.RangeDiffStatusRemoved:
+		return types.NewRunPtyTask(self.c.Git().Commit.ShowCmdObj(pair.OldSha, "").GetCmd())
+	default:
+		return types.NewRunPtyTask(self.c.Git().Commit.ShowCmdObj(pair.NewSha, "").GetCmd())
+	}
+}
+
+func (self *RangeDiffController) context() *context.RangeDiffContext {
+	return self.c.Contexts().RangeDiff
+}
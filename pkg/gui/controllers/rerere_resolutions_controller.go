@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type RerereResolutionsController struct {
+	baseController
+	*ListControllerTrait[*models.RerereResolution]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RerereResolutionsController{}
+
+func NewRerereResolutionsController(
+	c *ControllerCommon,
+) *RerereResolutionsController {
+	return &RerereResolutionsController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.RerereResolution](
+			c,
+			c.Contexts().RerereResolutions,
+			c.Contexts().RerereResolutions.GetSelected,
+			c.Contexts().RerereResolutions.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *RerereResolutionsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Remove),
+			Handler:           self.withItem(self.remove),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.DeleteRerereResolution,
+			Tooltip:           self.c.Tr.DeleteRerereResolutionTooltip,
+		},
+	}
+}
+
+func (self *RerereResolutionsController) GetOnRenderToMain() func() error {
+	return func() error {
+		var task types.UpdateTask
+		resolution := self.context().GetSelected()
+		if resolution == nil {
+			task = types.NewRenderStringTask(self.c.Tr.NoRerereResolutions)
+		} else if resolution.HasPostimage {
+			task = types.NewRunPtyTask(self.c.Git().Rerere.ResolutionDiffCmdObj(resolution).GetCmd())
+		} else {
+			// the conflict was recorded, but git never saw it resolved, e.g.
+			// because the merge was aborted
+			preimage, err := self.c.Git().Rerere.Preimage(resolution)
+			if err != nil {
+				return err
+			}
+			task = types.NewRenderStringTask(self.c.Tr.RerereNoResolutionRecorded + "\n\n" + preimage)
+		}
+
+		return self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.RerereResolutionTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+func (self *RerereResolutionsController) remove(resolution *models.RerereResolution) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.DeleteRerereResolution,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.DeleteRerereResolutionPrompt,
+			map[string]string{"id": resolution.ID()},
+		),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.DeleteRerereResolution)
+			if err := self.c.Git().Rerere.DeleteResolution(resolution); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Helpers().Rerere.RefreshResolutions()
+		},
+	})
+}
+
+func (self *RerereResolutionsController) context() *context.RerereResolutionsContext {
+	return self.c.Contexts().RerereResolutions
+}
@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type SparseCheckoutController struct {
+	baseController
+	*ListControllerTrait[*models.SparseCheckoutDirectory]
+	c *ControllerCommon
+}
+
+var _ types.IController = &SparseCheckoutController{}
+
+func NewSparseCheckoutController(
+	c *ControllerCommon,
+) *SparseCheckoutController {
+	return &SparseCheckoutController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.SparseCheckoutDirectory](
+			c,
+			c.Contexts().SparseCheckout,
+			c.Contexts().SparseCheckout.GetSelected,
+			c.Contexts().SparseCheckout.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *SparseCheckoutController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.New),
+			Handler:     self.add,
+			Description: self.c.Tr.AddSparseCheckoutDirectory,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Remove),
+			Handler:           self.withItem(self.remove),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.RemoveSparseCheckoutDirectory,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.SparseCheckout.Reapply),
+			Handler:           self.reapply,
+			GetDisabledReason: self.require(self.hasSparseCheckoutPatterns),
+			Description:       self.c.Tr.ReapplySparseCheckout,
+			Tooltip:           self.c.Tr.ReapplySparseCheckoutTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.SparseCheckout.Disable),
+			Handler:           self.disable,
+			GetDisabledReason: self.require(self.isSparseCheckout),
+			Description:       self.c.Tr.DisableSparseCheckout,
+			Tooltip:           self.c.Tr.DisableSparseCheckoutTooltip,
+		},
+	}
+}
+
+func (self *SparseCheckoutController) GetOnRenderToMain() func() error {
+	return func() error {
+		return self.c.Helpers().Diff.WithDiffModeCheck(func() error {
+			var task types.UpdateTask
+			dir := self.context().GetSelected()
+			if !self.c.Model().IsSparseCheckout {
+				task = types.NewRenderStringTask(self.c.Tr.NotASparseCheckout)
+			} else if dir == nil {
+				task = types.NewRenderStringTask(self.c.Tr.SparseCheckoutOfRootOnly)
+			} else {
+				cmdObj := self.c.Git().SparseCheckout.ListFilesCmdObj(dir.Path)
+				task = types.NewRunCommandTask(cmdObj.GetCmd())
+			}
+
+			return self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title: self.c.Tr.SparseCheckoutTitle,
+					Task:  task,
+				},
+			})
+		})
+	}
+}
+
+func (self *SparseCheckoutController) add() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.AddSparseCheckoutDirectoryPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetSparseCheckoutDirectorySuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			path = strings.Trim(strings.TrimSpace(path), "/")
+			if path == "" {
+				return nil
+			}
+
+			if self.c.Model().IsSparseCheckout {
+				return self.c.WithWaitingStatus(self.c.Tr.AddSparseCheckoutDirectory, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.AddSparseCheckoutDirectory)
+					if err := self.c.Git().SparseCheckout.Add([]string{path}); err != nil {
+						return self.c.Error(err)
+					}
+
+					return self.refresh()
+				})
+			}
+
+			// Turning a full checkout into a sparse one removes almost all files
+			// from the working tree, so we ask first
+			return self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.EnableSparseCheckoutTitle,
+				Prompt: utils.ResolvePlaceholderString(
+					self.c.Tr.EnableSparseCheckoutPrompt,
+					map[string]string{"path": path},
+				),
+				HandleConfirm: func() error {
+					return self.set(self.c.Tr.Actions.AddSparseCheckoutDirectory, []string{path})
+				},
+			})
+		},
+	})
+}
+
+func (self *SparseCheckoutController) remove(dir *models.SparseCheckoutDirectory) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RemoveSparseCheckoutDirectory,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.RemoveSparseCheckoutDirectoryPrompt,
+			map[string]string{"path": dir.Path},
+		),
+		HandleConfirm: func() error {
+			remaining := lo.FilterMap(self.c.Model().SparseCheckoutDirectories, func(other *models.SparseCheckoutDirectory, _ int) (string, bool) {
+				return other.Path, other.Path != dir.Path
+			})
+
+			return self.set(self.c.Tr.Actions.RemoveSparseCheckoutDirectory, remaining)
+		},
+	})
+}
+
+func (self *SparseCheckoutController) set(action string, paths []string) error {
+	return self.c.WithWaitingStatus(action, func(gocui.Task) error {
+		self.c.LogAction(action)
+		if err := self.c.Git().SparseCheckout.Set(paths); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.refresh()
+	})
+}
+
+func (self *SparseCheckoutController) reapply() error {
+	return self.c.WithWaitingStatus(self.c.Tr.ReapplySparseCheckout, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.ReapplySparseCheckout)
+
+		// if sparse checkout has been disabled, enabling it again brings back
+		// the directories it had before
+		var err error
+		if self.c.Model().IsSparseCheckout {
+			err = self.c.Git().SparseCheckout.Reapply()
+		} else {
+			err = self.c.Git().SparseCheckout.Enable()
+		}
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.refresh()
+	})
+}
+
+func (self *SparseCheckoutController) disable() error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DisableSparseCheckout,
+		Prompt: self.c.Tr.DisableSparseCheckoutTooltip,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DisableSparseCheckout, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DisableSparseCheckout)
+				if err := self.c.Git().SparseCheckout.Disable(); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.refresh()
+			})
+		},
+	})
+}
+
+func (self *SparseCheckoutController) refresh() error {
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *SparseCheckoutController) isSparseCheckout() *types.DisabledReason {
+	if !self.c.Model().IsSparseCheckout {
+		return &types.DisabledReason{Text: self.c.Tr.NotASparseCheckout}
+	}
+
+	return nil
+}
+
+// Reapplying also works after sparse checkout has been disabled, as long as
+// git still remembers the patterns
+func (self *SparseCheckoutController) hasSparseCheckoutPatterns() *types.DisabledReason {
+	if !self.c.Model().IsSparseCheckout && !self.c.Git().SparseCheckout.MightBeEnabled() {
+		return &types.DisabledReason{Text: self.c.Tr.NotASparseCheckout}
+	}
+
+	return nil
+}
+
+func (self *SparseCheckoutController) context() *context.SparseCheckoutContext {
+	return self.c.Contexts().SparseCheckout
+}
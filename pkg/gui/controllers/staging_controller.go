@@ -74,6 +74,26 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.EditHunkAndRefresh,
 			Description: self.c.Tr.EditHunk,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.ViewLineRangeHistory),
+			Handler:           self.ViewLineRangeHistory,
+			GetDisabledReason: self.canViewLineRangeHistory,
+			Description:       self.c.Tr.ViewLineRangeHistory,
+			Tooltip:           self.c.Tr.ViewLineRangeHistoryTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.StashSelection),
+			Handler:     self.StashSelection,
+			Description: self.c.Tr.StashSelection,
+			Tooltip:     self.c.Tr.StashSelectionTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.SkipHunk),
+			Handler:           self.SkipHunk,
+			GetDisabledReason: self.canSkipHunk,
+			Description:       self.c.Tr.SkipHunk,
+			Tooltip:           self.c.Tr.SkipHunkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -114,6 +134,13 @@ func (self *StagingController) GetOnFocusLost() func(types.OnFocusLostOpts) erro
 		self.context.SetState(nil)
 
 		if opts.NewContextKey != self.otherContext.GetKey() {
+			// popups come and go without interrupting a hunk-by-hunk
+			// walkthrough, but going anywhere else ends it
+			newContext := self.c.ContextForKey(opts.NewContextKey)
+			if newContext == nil || newContext.GetKind() == types.SIDE_CONTEXT || newContext.GetKind() == types.MAIN_CONTEXT {
+				self.c.Helpers().Staging.EndHunkWalkthrough()
+			}
+
 			self.c.Views().Staging.Wrap = true
 			self.c.Views().StagingSecondary.Wrap = true
 			_ = self.c.Contexts().Staging.Render(false)
@@ -150,8 +177,46 @@ func (self *StagingController) EditFile() error {
 	return self.c.Helpers().Files.EditFileAtLine(path, lineNumber)
 }
 
+func (self *StagingController) canViewLineRangeHistory() *types.DisabledReason {
+	file := self.c.Contexts().Files.GetSelectedFile()
+	if file != nil && (!file.Tracked || file.Added) {
+		return &types.DisabledReason{Text: self.c.Tr.LineHistoryNewFile}
+	}
+
+	// The history is looked up starting from HEAD, so we need the line numbers
+	// in HEAD's version of the file. For unstaged changes we only have them in
+	// the index's version, which is different if some changes are staged.
+	if !self.staged && file != nil && file.HasStagedChanges {
+		return &types.DisabledReason{Text: self.c.Tr.LineHistoryPartlyStaged}
+	}
+
+	return nil
+}
+
+func (self *StagingController) ViewLineRangeHistory() error {
+	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
+	if checkedOutBranch == nil {
+		return nil
+	}
+
+	self.context.GetMutex().Lock()
+	path := self.FilePath()
+	startLine, endLine := self.context.GetState().SelectedFileLineRange(true)
+	self.context.GetMutex().Unlock()
+
+	if path == "" {
+		return nil
+	}
+
+	return self.c.Helpers().SubCommits.ViewLineRangeHistory(
+		checkedOutBranch, path, startLine, endLine, self.c.Contexts().Files)
+}
+
 func (self *StagingController) Escape() error {
-	if self.context.GetState().SelectingRange() || self.context.GetState().SelectingHunk() {
+	// while staging hunks one by one, selecting by hunk is the norm, so we go
+	// straight back to the files panel
+	if self.context.GetState().SelectingRange() ||
+		(self.context.GetState().SelectingHunk() && !self.c.Helpers().Staging.IsWalkingThroughHunks()) {
 		self.context.GetState().SetLineSelectMode()
 		return self.c.PostRefreshUpdate(self.context)
 	}
@@ -159,6 +224,35 @@ func (self *StagingController) Escape() error {
 	return self.c.PopContext()
 }
 
+func (self *StagingController) canSkipHunk() *types.DisabledReason {
+	if self.staged || !self.c.Helpers().Staging.IsWalkingThroughHunks() {
+		return &types.DisabledReason{Text: self.c.Tr.OnlyWhileStagingHunksOneByOne}
+	}
+
+	return nil
+}
+
+func (self *StagingController) SkipHunk() error {
+	self.context.GetMutex().Lock()
+	state := self.context.GetState()
+	if state == nil {
+		self.context.GetMutex().Unlock()
+		return nil
+	}
+	lastHunk := state.SelectingLastHunk()
+	if !lastHunk {
+		state.SetHunkSelectMode()
+		state.CycleHunk(true)
+	}
+	self.context.GetMutex().Unlock()
+
+	if lastHunk {
+		return self.c.Helpers().Staging.WalkToNextFile()
+	}
+
+	return self.c.PostRefreshUpdate(self.context)
+}
+
 func (self *StagingController) TogglePanel() error {
 	if self.otherContext.GetState() != nil {
 		return self.c.PushContext(self.otherContext)
@@ -168,9 +262,35 @@ func (self *StagingController) TogglePanel() error {
 }
 
 func (self *StagingController) ToggleStaged() error {
+	if !self.staged && self.c.Helpers().Staging.IsWalkingThroughHunks() {
+		return self.stageAndWalkOn()
+	}
+
 	return self.applySelectionAndRefresh(self.staged)
 }
 
+// Hunks before the selected one have been skipped, so once we've staged the
+// last hunk of a file we're done with it, even if it still has unstaged changes
+func (self *StagingController) stageAndWalkOn() error {
+	self.context.GetMutex().Lock()
+	doneWithFile := self.context.GetState().SelectingHunk() && self.context.GetState().SelectingLastHunk()
+	self.context.GetMutex().Unlock()
+
+	if !doneWithFile {
+		return self.applySelectionAndRefresh(false)
+	}
+
+	if err := self.applySelection(false); err != nil {
+		return err
+	}
+
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Staging.WalkToNextFile()
+}
+
 func (self *StagingController) DiscardSelection() error {
 	reset := func() error { return self.applySelectionAndRefresh(true) }
 
@@ -239,6 +359,55 @@ func (self *StagingController) applySelection(reverse bool) error {
 	return nil
 }
 
+func (self *StagingController) StashSelection() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.StashChanges,
+		HandleConfirm: func(stashComment string) error {
+			if err := self.stashSelection(stashComment); err != nil {
+				return err
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH, types.FILES, types.STAGING}})
+		},
+	})
+}
+
+func (self *StagingController) stashSelection(message string) error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	path := self.FilePath()
+	if state == nil || path == "" {
+		return nil
+	}
+
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+	patchToStash := patch.
+		Parse(state.GetDiff()).
+		Transform(patch.TransformOpts{
+			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			FileNameOverride:    path,
+		}).
+		FormatPlain()
+
+	if patchToStash == "" {
+		return nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.StashSelection)
+	if err := self.c.Git().Patch.StashPatch(message, patchToStash, self.staged); err != nil {
+		return self.c.Error(err)
+	}
+
+	if state.SelectingRange() {
+		firstLine, _ := state.SelectedRange()
+		state.SelectLine(firstLine)
+	}
+
+	return nil
+}
+
 func (self *StagingController) EditHunkAndRefresh() error {
 	if err := self.editHunk(); err != nil {
 		return err
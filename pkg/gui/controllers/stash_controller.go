@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -62,6 +63,13 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.RenameStash,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Stash.BranchFromStash),
+			Handler:           self.withItem(self.handleBranchFromStashEntry),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.BranchFromStash,
+			Tooltip:           self.c.Tr.BranchFromStashTooltip,
+		},
 	}
 
 	return bindings
@@ -168,6 +176,36 @@ func (self *StashController) handleNewBranchOffStashEntry(stashEntry *models.Sta
 	return self.c.Helpers().Refs.NewBranch(stashEntry.RefName(), stashEntry.Description(), "")
 }
 
+func (self *StashController) handleBranchFromStashEntry(stashEntry *models.StashEntry) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.BranchFromStashPrompt,
+			map[string]string{
+				"stashName": stashEntry.Description(),
+			},
+		),
+		HandleConfirm: func(response string) error {
+			self.c.LogAction(self.c.Tr.Actions.BranchFromStash)
+			err := self.c.Git().Stash.StashBranch(helpers.SanitizedBranchName(response), stashEntry.Index)
+			if err != nil {
+				// if the stash entry didn't apply cleanly the branch has still
+				// been created, so we need to refresh either way
+				_ = self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI})
+				return self.c.Error(err)
+			}
+
+			if err := self.c.PushContext(self.c.Contexts().Branches); err != nil {
+				return err
+			}
+
+			self.c.Contexts().LocalCommits.SetSelection(0)
+			self.c.Contexts().Branches.SetSelection(0)
+
+			return self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI, KeepBranchSelectionIndex: true})
+		},
+	})
+}
+
 func (self *StashController) handleRenameStashEntry(stashEntry *models.StashEntry) error {
 	message := utils.ResolvePlaceholderString(
 		self.c.Tr.RenameStashPrompt,
@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -46,7 +47,13 @@ func (self *SubCommitsController) GetOnRenderToMain() func() error {
 			if commit == nil {
 				task = types.NewRenderStringTask("No commits")
 			} else {
-				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
+				var cmdObj oscommands.ICmdObj
+				if lineRange := self.context().GetFilterLineRange(); lineRange != "" {
+					cmdObj = self.c.Git().Commit.ShowLineRangeCmdObj(
+						self.context().GetRef().FullRefName(), lineRange, self.context().GetSelectedLineIdx())
+				} else {
+					cmdObj = self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
+				}
 
 				task = types.NewRunPtyTask(cmdObj.GetCmd())
 			}
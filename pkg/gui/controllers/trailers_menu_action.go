@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type TrailersMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *TrailersMenuAction) Call() error {
+	identity, hasIdentity := self.c.Git().Config.GetUserIdentity()
+	var signOffDisabledReason *types.DisabledReason
+	if !hasIdentity {
+		signOffDisabledReason = &types.DisabledReason{Text: self.c.Tr.UserIdentityNotConfigured}
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: "Signed-off-by",
+			OnPress: func() error {
+				return self.c.Helpers().Commits.AddTrailer("Signed-off-by", identity)
+			},
+			Key:            's',
+			DisabledReason: signOffDisabledReason,
+		},
+		self.promptedTrailerMenuItem("Co-authored-by", 'c'),
+		self.promptedTrailerMenuItem("Reviewed-by", 'r'),
+	}
+
+	for _, trailer := range self.c.UserConfig.Git.Commit.Trailers {
+		menuItems = append(menuItems, self.promptedTrailerMenuItem(trailer, nil))
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AddTrailer,
+		Items: menuItems,
+	})
+}
+
+// The value of most trailers is somebody else, so we suggest the authors we
+// know of
+func (self *TrailersMenuAction) promptedTrailerMenuItem(trailer string, key types.Key) *types.MenuItem {
+	return &types.MenuItem{
+		Label: trailer,
+		OnPress: func() error {
+			return self.c.Prompt(types.PromptOpts{
+				Title:               trailer,
+				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetAuthorsSuggestionsFunc(),
+				HandleConfirm: func(value string) error {
+					return self.c.Helpers().Commits.AddTrailer(trailer, value)
+				},
+			})
+		},
+		Key: key,
+	}
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Handles the conflicts that can't be resolved hunk by hunk because git didn't
+// write conflict markers into the file, e.g. because one side deleted it, or
+// because it's binary or a submodule. These are resolved by deciding what to do
+// with the file as a whole.
+type WholeFileConflictMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *WholeFileConflictMenuAction) Call(file *models.File) error {
+	keepOurs := self.checkoutVersionItem(file, "ours", self.c.Tr.KeepOurVersion, self.c.Tr.CheckoutOursTooltip)
+	keepTheirs := self.checkoutVersionItem(file, "theirs", self.c.Tr.KeepTheirVersion, self.c.Tr.CheckoutTheirsTooltip)
+	// for the conflicts where only one side still has the file, the working
+	// tree has that side's version, so all we need to do to keep it is to
+	// stage it
+	keepOursAsIs := self.stageItem(file, self.c.Tr.KeepOurVersion, self.c.Tr.KeepFileTooltip)
+	keepTheirsAsIs := self.stageItem(file, self.c.Tr.KeepTheirVersion, self.c.Tr.KeepFileTooltip)
+	keepDeleted := self.removeItem(file, self.c.Tr.KeepDeleted)
+	deleteFile := self.removeItem(file, self.c.Tr.DeleteConflictedFile)
+
+	var items []*types.MenuItem
+	if submoduleConfig := file.SubmoduleConfig(self.c.Model().Submodules); submoduleConfig != nil {
+		items = []*types.MenuItem{
+			self.stageItem(file, self.c.Tr.StageSubmoduleCommit, self.c.Tr.StageSubmoduleCommitTooltip),
+			{
+				Label: self.c.Tr.EnterSubmodule,
+				OnPress: func() error {
+					return self.c.Helpers().Repos.EnterSubmodule(submoduleConfig)
+				},
+			},
+		}
+	} else {
+		switch file.ConflictType() {
+		case models.ConflictTypeBothModified, models.ConflictTypeBothAdded:
+			items = []*types.MenuItem{keepOurs, keepTheirs}
+		case models.ConflictTypeBothDeleted:
+			items = []*types.MenuItem{keepDeleted}
+		case models.ConflictTypeAddedByUs:
+			items = []*types.MenuItem{keepOursAsIs, deleteFile}
+		case models.ConflictTypeAddedByThem:
+			items = []*types.MenuItem{keepTheirsAsIs, deleteFile}
+		case models.ConflictTypeDeletedByUs:
+			items = []*types.MenuItem{keepTheirsAsIs, keepDeleted}
+		case models.ConflictTypeDeletedByThem:
+			items = []*types.MenuItem{keepOursAsIs, keepDeleted}
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf("%s: %s", self.conflictTypeName(file), file.Name),
+		Items: items,
+	})
+}
+
+// Explains what happened to the file, to be shown in the main view
+func (self *WholeFileConflictMenuAction) Explanation(file *models.File) string {
+	explanation := ""
+	if file.IsSubmodule(self.c.Model().Submodules) {
+		explanation = self.c.Tr.ConflictSubmoduleExplanation
+	} else {
+		switch file.ConflictType() {
+		case models.ConflictTypeBothModified:
+			explanation = self.c.Tr.ConflictModifiedBinaryExplanation
+		case models.ConflictTypeBothAdded:
+			explanation = self.c.Tr.ConflictBothAddedBinaryExplanation
+		case models.ConflictTypeBothDeleted:
+			explanation = self.c.Tr.ConflictBothDeletedExplanation
+		case models.ConflictTypeAddedByUs:
+			explanation = self.c.Tr.ConflictAddedByUsExplanation
+		case models.ConflictTypeAddedByThem:
+			explanation = self.c.Tr.ConflictAddedByThemExplanation
+		case models.ConflictTypeDeletedByUs:
+			explanation = self.c.Tr.ConflictDeletedByUsExplanation
+		case models.ConflictTypeDeletedByThem:
+			explanation = self.c.Tr.ConflictDeletedByThemExplanation
+		}
+	}
+
+	hint := utils.ResolvePlaceholderString(self.c.Tr.ResolveWholeFileConflictHint, map[string]string{
+		"key": keybindings.Label(self.c.UserConfig.Keybinding.Universal.GoInto),
+	})
+
+	return fmt.Sprintf("%s: %s\n\n%s", self.conflictTypeName(file), explanation, hint)
+}
+
+func (self *WholeFileConflictMenuAction) conflictTypeName(file *models.File) string {
+	switch file.ConflictType() {
+	case models.ConflictTypeBothModified:
+		return self.c.Tr.ConflictBothModified
+	case models.ConflictTypeBothAdded:
+		return self.c.Tr.ConflictBothAdded
+	case models.ConflictTypeBothDeleted:
+		return self.c.Tr.ConflictBothDeleted
+	case models.ConflictTypeAddedByUs:
+		return self.c.Tr.ConflictAddedByUs
+	case models.ConflictTypeAddedByThem:
+		return self.c.Tr.ConflictAddedByThem
+	case models.ConflictTypeDeletedByUs:
+		return self.c.Tr.ConflictDeletedByUs
+	case models.ConflictTypeDeletedByThem:
+		return self.c.Tr.ConflictDeletedByThem
+	default:
+		return self.c.Tr.MergeConflictsTitle
+	}
+}
+
+func (self *WholeFileConflictMenuAction) checkoutVersionItem(file *models.File, version string, label string, tooltip string) *types.MenuItem {
+	return &types.MenuItem{
+		Label:   label,
+		Tooltip: tooltip,
+		OnPress: func() error {
+			self.c.LogAction(self.c.Tr.Actions.CheckoutConflictVersion)
+			if err := self.c.Git().WorkingTree.CheckoutConflictVersion(file.Name, version); err != nil {
+				return self.c.Error(err)
+			}
+			if err := self.c.Git().WorkingTree.StageFile(file.Name); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.refresh()
+		},
+	}
+}
+
+func (self *WholeFileConflictMenuAction) stageItem(file *models.File, label string, tooltip string) *types.MenuItem {
+	return &types.MenuItem{
+		Label:   label,
+		Tooltip: tooltip,
+		OnPress: func() error {
+			self.c.LogAction(self.c.Tr.Actions.StageFile)
+			if err := self.c.Git().WorkingTree.StageFile(file.Name); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.refresh()
+		},
+	}
+}
+
+func (self *WholeFileConflictMenuAction) removeItem(file *models.File, label string) *types.MenuItem {
+	return &types.MenuItem{
+		Label:   label,
+		Tooltip: self.c.Tr.RemoveConflictedFileTooltip,
+		OnPress: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RemoveConflictedFile)
+			if err := self.c.Git().WorkingTree.RemoveConflictedFile(file.Name); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.refresh()
+		},
+	}
+}
+
+func (self *WholeFileConflictMenuAction) refresh() error {
+	// as part of refreshing files, we handle the situation where the last
+	// conflicted file has been resolved
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
@@ -57,11 +57,12 @@ func (gui *Gui) handleEditorKeypress(textArea *gocui.TextArea, key gocui.Key, ch
 }
 
 // we've just copy+pasted the editor from gocui to here so that we can also re-
-// render the commit message length on each keypress
+// render the commit message length and lint violations on each keypress
 func (gui *Gui) commitMessageEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
 	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, false)
 	v.RenderTextArea()
 	gui.c.Contexts().CommitMessage.RenderCommitLength()
+	gui.helpers.Commits.RenderCommitLint()
 	return matched
 }
 
@@ -69,6 +70,13 @@ func (gui *Gui) commitDescriptionEditor(v *gocui.View, key gocui.Key, ch rune, m
 	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, true)
 	v.RenderTextArea()
 	gui.c.Contexts().CommitMessage.RenderCommitLength()
+	gui.helpers.Commits.RenderCommitLint()
+	return matched
+}
+
+func (gui *Gui) mergeEditorResultEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
+	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, true)
+	v.RenderTextArea()
 	return matched
 }
 
@@ -0,0 +1,114 @@
+package mergeconflicts
+
+import (
+	"strings"
+)
+
+// ConflictSides holds the different versions of a single conflict, as shown
+// side by side in the merge editor
+type ConflictSides struct {
+	Ours   []string
+	Theirs []string
+	// nil if the conflict has no base section, i.e. unless merge.conflictStyle
+	// is diff3 or zdiff3
+	Base []string
+
+	// the labels git put after the conflict markers, e.g. "HEAD" or the name
+	// of the branch being merged
+	OursLabel   string
+	BaseLabel   string
+	TheirsLabel string
+}
+
+func (s *State) GetConflictIndex() int {
+	return s.conflictIndex
+}
+
+func (s *State) SelectConflict(index int) {
+	s.setConflictIndex(index)
+}
+
+func (s *State) ConflictCount() int {
+	return len(s.conflicts)
+}
+
+// Returns the versions of the currently selected conflict, or nil if there
+// are no conflicts left
+func (s *State) CurrentConflictSides() *ConflictSides {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return nil
+	}
+
+	lines := contentLines(s.GetContent())
+	sectionLines := func(start int, end int) []string {
+		result := make([]string, 0, end-start-1)
+		for _, line := range lines[start+1 : end] {
+			result = append(result, trimLineEnding(line))
+		}
+		return result
+	}
+
+	sides := &ConflictSides{
+		Theirs:      sectionLines(conflict.target, conflict.end),
+		OursLabel:   markerLabel(lines[conflict.start]),
+		TheirsLabel: markerLabel(lines[conflict.end]),
+	}
+
+	if conflict.hasAncestor() {
+		sides.Ours = sectionLines(conflict.start, conflict.ancestor)
+		sides.Base = sectionLines(conflict.ancestor, conflict.target)
+		sides.BaseLabel = markerLabel(lines[conflict.ancestor])
+	} else {
+		sides.Ours = sectionLines(conflict.start, conflict.target)
+	}
+
+	return sides
+}
+
+// Returns the content of the file with the currently selected conflict,
+// including its markers, replaced by the given lines. The lines get the same
+// line endings as the conflict markers.
+func (s *State) ContentWithResolution(resolution []string) (bool, string) {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return false, ""
+	}
+
+	lines := contentLines(s.GetContent())
+	lineEnding := "\n"
+	if strings.HasSuffix(lines[conflict.start], "\r\n") {
+		lineEnding = "\r\n"
+	}
+
+	var builder strings.Builder
+	for _, line := range lines[:conflict.start] {
+		builder.WriteString(line)
+	}
+	for _, line := range resolution {
+		builder.WriteString(line + lineEnding)
+	}
+	for _, line := range lines[conflict.end+1:] {
+		builder.WriteString(line)
+	}
+
+	return true, builder.String()
+}
+
+// Splits the content into lines, keeping their line endings so that we can
+// put the content back together unchanged. The indices match those of
+// utils.SplitLines, which we use for finding the conflicts.
+func contentLines(content string) []string {
+	return strings.SplitAfter(content, "\n")
+}
+
+func trimLineEnding(line string) string {
+	return strings.TrimRight(line, "\r\n")
+}
+
+// e.g. "<<<<<<< HEAD" -> "HEAD"
+func markerLabel(line string) string {
+	line = strings.TrimPrefix(trimLineEnding(line), "++")
+	_, label, _ := strings.Cut(line, " ")
+	return label
+}
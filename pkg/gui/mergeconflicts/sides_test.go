@@ -0,0 +1,120 @@
+package mergeconflicts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrentConflictSides(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		content       string
+		conflictIndex int
+		expected      *ConflictSides
+	}{
+		{
+			name:     "no conflicts",
+			content:  "foo\nbar\n",
+			expected: nil,
+		},
+		{
+			name:    "conflict without base",
+			content: "before\n<<<<<<< HEAD\nfoo\nbar\n=======\nbaz\n>>>>>>> branch\nafter\n",
+			expected: &ConflictSides{
+				Ours:        []string{"foo", "bar"},
+				Theirs:      []string{"baz"},
+				OursLabel:   "HEAD",
+				TheirsLabel: "branch",
+			},
+		},
+		{
+			name: "second conflict with base, as produced by zdiff3",
+			content: "<<<<<<< HEAD\none\n=======\ntwo\n>>>>>>> branch\n" +
+				"<<<<<<< ours\r\nfoo\r\n||||||| base\r\n\r\n=======\r\nbaz\r\n>>>>>>> theirs\r\n",
+			conflictIndex: 1,
+			expected: &ConflictSides{
+				Ours:        []string{"foo"},
+				Base:        []string{""},
+				Theirs:      []string{"baz"},
+				OursLabel:   "ours",
+				BaseLabel:   "base",
+				TheirsLabel: "theirs",
+			},
+		},
+		{
+			name:    "empty sections",
+			content: "<<<<<<< HEAD\n||||||| base\n=======\n>>>>>>> branch\n",
+			expected: &ConflictSides{
+				Ours:        []string{},
+				Base:        []string{},
+				Theirs:      []string{},
+				OursLabel:   "HEAD",
+				BaseLabel:   "base",
+				TheirsLabel: "branch",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState()
+			state.SetContent(s.content, "file")
+			state.SelectConflict(s.conflictIndex)
+			assert.Equal(t, s.expected, state.CurrentConflictSides())
+		})
+	}
+}
+
+func TestContentWithResolution(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		content         string
+		conflictIndex   int
+		resolution      []string
+		expectedOk      bool
+		expectedContent string
+	}{
+		{
+			name:       "no conflicts",
+			content:    "foo\n",
+			resolution: []string{"bar"},
+			expectedOk: false,
+		},
+		{
+			name:            "replaces the selected conflict only",
+			content:         "a\n<<<<<<< HEAD\nb\n=======\nc\n>>>>>>> x\nd\n<<<<<<< HEAD\ne\n||||||| base\nf\n=======\ng\n>>>>>>> x\nh",
+			conflictIndex:   1,
+			resolution:      []string{"g", "e"},
+			expectedOk:      true,
+			expectedContent: "a\n<<<<<<< HEAD\nb\n=======\nc\n>>>>>>> x\nd\ng\ne\nh",
+		},
+		{
+			name:            "empty resolution removes the conflict",
+			content:         "a\n<<<<<<< HEAD\nb\n=======\nc\n>>>>>>> x\n",
+			resolution:      []string{},
+			expectedOk:      true,
+			expectedContent: "a\n",
+		},
+		{
+			name:            "keeps windows line endings",
+			content:         "a\r\n<<<<<<< HEAD\r\nb\r\n=======\r\nc\r\n>>>>>>> x\r\nd\r\n",
+			resolution:      []string{"b", "c"},
+			expectedOk:      true,
+			expectedContent: "a\r\nb\r\nc\r\nd\r\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState()
+			state.SetContent(s.content, "file")
+			state.SelectConflict(s.conflictIndex)
+			ok, content := state.ContentWithResolution(s.resolution)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expectedContent, content)
+		})
+	}
+}
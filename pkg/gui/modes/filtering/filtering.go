@@ -1,7 +1,10 @@
 package filtering
 
+import "github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+
 type Filtering struct {
-	path string // the filename that gets passed to git log
+	path      string                 // the filename that gets passed to git log
+	logFilter git_commands.LogFilter // author, message etc. that get passed to git log
 }
 
 func New(path string) Filtering {
@@ -9,11 +12,12 @@ func New(path string) Filtering {
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != ""
+	return m.path != "" || !m.logFilter.IsEmpty()
 }
 
 func (m *Filtering) Reset() {
 	m.path = ""
+	m.logFilter = git_commands.LogFilter{}
 }
 
 func (m *Filtering) SetPath(path string) {
@@ -23,3 +27,11 @@ func (m *Filtering) SetPath(path string) {
 func (m *Filtering) GetPath() string {
 	return m.path
 }
+
+func (m *Filtering) SetLogFilter(logFilter git_commands.LogFilter) {
+	m.logFilter = logFilter
+}
+
+func (m *Filtering) GetLogFilter() git_commands.LogFilter {
+	return m.logFilter
+}
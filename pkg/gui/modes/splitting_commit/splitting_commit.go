@@ -0,0 +1,25 @@
+package splitting_commit
+
+type SplittingCommit struct {
+	sha string // the sha of the commit being split into several commits; empty string when not splitting
+}
+
+func New() SplittingCommit {
+	return SplittingCommit{}
+}
+
+func (m *SplittingCommit) Active() bool {
+	return m.sha != ""
+}
+
+func (m *SplittingCommit) Reset() {
+	m.sha = ""
+}
+
+func (m *SplittingCommit) SetSha(sha string) {
+	m.sha = sha
+}
+
+func (m *SplittingCommit) GetSha() string {
+	return m.sha
+}
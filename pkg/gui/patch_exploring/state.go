@@ -3,6 +3,7 @@ package patch_exploring
 import (
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,6 +19,19 @@ type State struct {
 	diff          string
 	patch         *patch.Patch
 	selectMode    selectMode
+	// The hunks that have been split into pieces, identified by the lines
+	// they span in the new version of the file rather than by patch line so
+	// that they stay split when the diff changes, e.g. after staging a piece.
+	splitHunks []fileLineRange
+	// The hunks, or their pieces if they've been split, that we step through
+	// when selecting by hunk
+	hunkRanges []patch.LineRange
+}
+
+// An inclusive range of line numbers in a file
+type fileLineRange struct {
+	start int
+	end   int
 }
 
 // these represent what select mode we're in
@@ -44,8 +58,10 @@ func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Ent
 	}
 
 	rangeStartLineIdx := 0
+	var splitHunks []fileLineRange
 	if oldState != nil {
 		rangeStartLineIdx = oldState.rangeStartLineIdx
+		splitHunks = oldState.splitHunks
 	}
 
 	selectMode := LINE
@@ -63,14 +79,18 @@ func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Ent
 		selectedLineIdx = patch.GetNextChangeIdx(0)
 	}
 
-	return &State{
+	state := &State{
 		patch:             patch,
 		selectedLineIdx:   selectedLineIdx,
 		selectMode:        selectMode,
 		rangeStartLineIdx: rangeStartLineIdx,
 		rangeIsSticky:     false,
 		diff:              diff,
+		splitHunks:        splitHunks,
 	}
+	state.calculateHunkRanges()
+
+	return state
 }
 
 func (s *State) GetSelectedLineIdx() int {
@@ -123,6 +143,10 @@ func (s *State) SetLineSelectMode() {
 	s.selectMode = LINE
 }
 
+func (s *State) SetHunkSelectMode() {
+	s.selectMode = HUNK
+}
+
 // For when you move the cursor without holding shift (meaning if we're in
 // a non-sticky range select, we'll cancel it)
 func (s *State) SelectLine(newSelectedLineIdx int) {
@@ -172,16 +196,81 @@ func (s *State) CycleHunk(forward bool) {
 		change = -1
 	}
 
+	rangeIdx := s.currentHunkRangeIdx()
+	if rangeIdx != -1 {
+		newRangeIdx := rangeIdx + change
+		if newRangeIdx >= 0 && newRangeIdx < len(s.hunkRanges) {
+			s.selectedLineIdx = s.patch.GetNextChangeIdx(s.hunkRanges[newRangeIdx].Start)
+		}
+	}
+}
+
+// Returns true if the selected hunk is the last one (or the last piece of the
+// last one)
+func (s *State) SelectingLastHunk() bool {
+	return s.currentHunkRangeIdx() == len(s.hunkRanges)-1
+}
+
+// Splits the selected hunk into pieces at its unchanged lines, like `git add
+// -p` does, and selects the piece containing the selected line. Returns false
+// if the hunk has already been split or can't be split any further.
+func (s *State) SplitHunk() bool {
+	if !s.CanSplitHunk() {
+		return false
+	}
+
 	hunkIdx := s.patch.HunkContainingLine(s.selectedLineIdx)
-	if hunkIdx != -1 {
-		newHunkIdx := hunkIdx + change
-		if newHunkIdx >= 0 && newHunkIdx < s.patch.HunkCount() {
-			start := s.patch.HunkStartIdx(newHunkIdx)
-			s.selectedLineIdx = s.patch.GetNextChangeIdx(start)
+	s.splitHunks = append(s.splitHunks, s.fileLineRangeOfHunk(hunkIdx))
+	s.calculateHunkRanges()
+
+	s.selectMode = HUNK
+	start, _ := s.CurrentHunkBounds()
+	s.selectedLineIdx = s.patch.GetNextChangeIdx(start)
+	return true
+}
+
+func (s *State) CanSplitHunk() bool {
+	hunkIdx := s.patch.HunkContainingLine(s.selectedLineIdx)
+	return hunkIdx != -1 && !s.isHunkSplit(hunkIdx) && len(s.patch.HunkPieces(hunkIdx)) >= 2
+}
+
+func (s *State) calculateHunkRanges() {
+	s.hunkRanges = []patch.LineRange{}
+	for hunkIdx := 0; hunkIdx < s.patch.HunkCount(); hunkIdx++ {
+		if s.isHunkSplit(hunkIdx) {
+			s.hunkRanges = append(s.hunkRanges, s.patch.HunkPieces(hunkIdx)...)
+		} else {
+			s.hunkRanges = append(s.hunkRanges, patch.LineRange{
+				Start: s.patch.HunkStartIdx(hunkIdx),
+				End:   s.patch.HunkEndIdx(hunkIdx),
+			})
 		}
 	}
 }
 
+func (s *State) isHunkSplit(hunkIdx int) bool {
+	hunkRange := s.fileLineRangeOfHunk(hunkIdx)
+	return lo.SomeBy(s.splitHunks, func(splitHunk fileLineRange) bool {
+		return splitHunk.start <= hunkRange.end && hunkRange.start <= splitHunk.end
+	})
+}
+
+func (s *State) fileLineRangeOfHunk(hunkIdx int) fileLineRange {
+	return fileLineRange{
+		start: s.patch.LineNumberOfLine(s.patch.HunkStartIdx(hunkIdx)),
+		end:   s.patch.LineNumberOfLine(s.patch.HunkEndIdx(hunkIdx)),
+	}
+}
+
+// Returns the index into hunkRanges of the hunk (or piece) containing the
+// selected line, or -1 if the selected line isn't in a hunk
+func (s *State) currentHunkRangeIdx() int {
+	_, idx, _ := lo.FindIndexOf(s.hunkRanges, func(r patch.LineRange) bool {
+		return r.Start <= s.selectedLineIdx && s.selectedLineIdx <= r.End
+	})
+	return idx
+}
+
 func (s *State) CycleLine(forward bool) {
 	change := 1
 	if !forward {
@@ -208,8 +297,13 @@ func (s *State) CycleRange(forward bool) {
 	s.selectLineWithoutRangeCheck(s.selectedLineIdx + change)
 }
 
-// returns first and last patch line index of current hunk
+// returns first and last patch line index of current hunk, or of the current
+// piece of it if the hunk has been split
 func (s *State) CurrentHunkBounds() (int, int) {
+	if rangeIdx := s.currentHunkRangeIdx(); rangeIdx != -1 {
+		return s.hunkRanges[rangeIdx].Start, s.hunkRanges[rangeIdx].End
+	}
+
 	hunkIdx := s.patch.HunkContainingLine(s.selectedLineIdx)
 	start := s.patch.HunkStartIdx(hunkIdx)
 	end := s.patch.HunkEndIdx(hunkIdx)
@@ -238,14 +332,25 @@ func (s *State) CurrentLineNumber() int {
 	return s.patch.LineNumberOfLine(s.selectedLineIdx)
 }
 
+// Returns the first and last line number of the selected range in the file
+// (either the old or the new version of it, depending on oldFile)
+func (s *State) SelectedFileLineRange(oldFile bool) (int, int) {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	if oldFile {
+		return s.patch.OldLineNumberOfLine(firstLineIdx), s.patch.OldLineNumberOfLine(lastLineIdx)
+	}
+	return s.patch.LineNumberOfLine(firstLineIdx), s.patch.LineNumberOfLine(lastLineIdx)
+}
+
 func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int) string {
+func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int, inlineDiffMode patch.InlineDiffMode) string {
 	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
 	return s.patch.FormatView(patch.FormatViewOpts{
 		IncLineIndices: includedLineIndicesSet,
+		InlineDiffMode: inlineDiffMode,
 	})
 }
 
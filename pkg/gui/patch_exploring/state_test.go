@@ -0,0 +1,99 @@
+package patch_exploring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const threeChangesInOneHunk = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,7 +1,7 @@
+-a
++A
+ b
+-c
++C
+ d
+-e
++E
+ f
+`
+
+// the same diff after staging the first change
+const twoChangesInOneHunk = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,7 +1,7 @@
+ A
+ b
+-c
++C
+ d
+-e
++E
+ f
+`
+
+func TestSplitHunk(t *testing.T) {
+	state := NewState(threeChangesInOneHunk, -1, nil, nil)
+	assert.Equal(t, 5, state.GetSelectedLineIdx())
+
+	assert.True(t, state.SplitHunk())
+	assert.True(t, state.SelectingHunk())
+	assertHunkBounds(t, state, 4, 7)
+
+	// a hunk can only be split once
+	assert.False(t, state.SplitHunk())
+
+	state.CycleHunk(true)
+	assert.Equal(t, 8, state.GetSelectedLineIdx())
+	assertHunkBounds(t, state, 8, 10)
+	assert.False(t, state.SelectingLastHunk())
+
+	state.CycleHunk(true)
+	assertHunkBounds(t, state, 11, 13)
+	assert.True(t, state.SelectingLastHunk())
+
+	state.CycleHunk(true)
+	assertHunkBounds(t, state, 11, 13)
+
+	state.CycleHunk(false)
+	assertHunkBounds(t, state, 8, 10)
+
+	// the hunk stays split when the diff changes
+	state = NewState(twoChangesInOneHunk, -1, state, nil)
+	assert.True(t, state.SelectingHunk())
+	assertHunkBounds(t, state, 4, 9)
+
+	// but a fresh state doesn't know about the split
+	state = NewState(twoChangesInOneHunk, -1, nil, nil)
+	assertHunkBounds(t, state, 4, 12)
+}
+
+func TestSplitHunkWithSingleChange(t *testing.T) {
+	state := NewState(`diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+`, -1, nil, nil)
+
+	assert.False(t, state.SplitHunk())
+	assertHunkBounds(t, state, 4, 8)
+}
+
+func assertHunkBounds(t *testing.T, state *State, expectedStart int, expectedEnd int) {
+	t.Helper()
+
+	start, end := state.CurrentHunkBounds()
+	assert.Equal(t, expectedStart, start)
+	assert.Equal(t, expectedEnd, end)
+}
@@ -0,0 +1,33 @@
+package presentation
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetBlameLineListDisplayStrings(lines []*models.BlameLine) [][]string {
+	return lo.Map(lines, func(line *models.BlameLine, _ int) []string {
+		return getBlameLineDisplayStrings(line)
+	})
+}
+
+func getBlameLineDisplayStrings(line *models.BlameLine) []string {
+	shaStyle := style.FgYellow
+	if line.IsNotCommittedYet() {
+		shaStyle = style.FgRed
+	}
+
+	return []string{
+		shaStyle.Sprint(utils.ShortSha(line.Sha)),
+		authors.LongAuthor(line.Author),
+		style.FgMagenta.Sprint(utils.UnixToTimeAgo(line.AuthorTimestamp)),
+		style.FgCyan.Sprint(strconv.Itoa(line.LineNumber)),
+		theme.DefaultTextColor.Sprint(line.Content),
+	}
+}
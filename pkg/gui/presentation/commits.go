@@ -106,25 +106,26 @@ func GetCommitListDisplayStrings(
 	// branch marker in the commits list. We only want to do this for branches
 	// that are not the current branch, and not any of the main branches. The
 	// goal is to visualize stacks of local branches, so anything that doesn't
-	// contribute to a branch stack shouldn't show a marker.
+	// contribute to a branch stack shouldn't show a marker. If the user asks for
+	// it, the marker is followed by the names of the branches, so that you can
+	// see where each branch of the stack ends.
 	//
 	// If there are other branches pointing to the current head commit, we only
 	// want to show the marker if the rebase.updateRefs config is on.
-	branchHeadsToVisualize := set.NewFromSlice(lo.FilterMap(branches,
-		func(b *models.Branch, index int) (string, bool) {
-			return b.CommitHash,
-				// Don't consider branches that don't have a commit hash. As far
-				// as I can see, this happens for a detached head, so filter
-				// these out
-				b.CommitHash != "" &&
-					// Don't show a marker for the current branch
-					b.Name != currentBranchName &&
-					// Don't show a marker for main branches
-					!lo.Contains(common.UserConfig.Git.MainBranches, b.Name) &&
-					// Don't show a marker for the head commit unless the
-					// rebase.updateRefs config is on
-					(showBranchMarkerForHeadCommit || b.CommitHash != commits[0].Sha)
-		}))
+	branchHeadsToVisualize := lo.GroupBy(lo.Filter(branches,
+		func(b *models.Branch, index int) bool {
+			// Don't consider branches that don't have a commit hash. As far
+			// as I can see, this happens for a detached head, so filter
+			// these out
+			return b.CommitHash != "" &&
+				// Don't show a marker for the current branch
+				b.Name != currentBranchName &&
+				// Don't show a marker for main branches
+				!lo.Contains(common.UserConfig.Git.MainBranches, b.Name) &&
+				// Don't show a marker for the head commit unless the
+				// rebase.updateRefs config is on
+				(showBranchMarkerForHeadCommit || b.CommitHash != commits[0].Sha)
+		}), func(b *models.Branch) string { return b.CommitHash })
 
 	lines := make([][]string, 0, len(filteredCommits))
 	var bisectStatus BisectStatus
@@ -295,7 +296,7 @@ func getBisectStatusText(bisectStatus BisectStatus, bisectInfo *git_commands.Bis
 func displayCommit(
 	common *common.Common,
 	commit *models.Commit,
-	branchHeadsToVisualize *set.Set[string],
+	branchHeadsToVisualize map[string][]*models.Branch,
 	cherryPickedCommitShaSet *set.Set[string],
 	isMarkedBaseCommit bool,
 	willBeRebased bool,
@@ -329,12 +330,25 @@ func displayCommit(
 			tagString = theme.DiffTerminalColor.SetBold().Sprint(strings.Join(commit.Tags, " ")) + " "
 		}
 
-		if branchHeadsToVisualize.Includes(commit.Sha) && commit.Status != models.StatusMerged {
-			tagString = style.FgCyan.SetBold().Sprint(
-				lo.Ternary(icons.IsIconEnabled(), icons.BRANCH_ICON, "*") + " " + tagString)
+		if branchHeads, ok := branchHeadsToVisualize[commit.Sha]; ok && commit.Status != models.StatusMerged {
+			marker := lo.Ternary(icons.IsIconEnabled(), icons.BRANCH_ICON, "*")
+			if common.UserConfig.Gui.ShowBranchStackNames {
+				marker += " " + strings.Join(lo.Map(branchHeads, func(b *models.Branch, _ int) string { return b.Name }), " ")
+			}
+			tagString = style.FgCyan.SetBold().Sprint(marker + " " + tagString)
 		}
 	}
 
+	signatureString := ""
+	if commit.Signature != nil {
+		signatureString = getSignatureMarker(commit.Signature.Status) + " "
+	}
+
+	notesString := ""
+	if commit.HasNotes {
+		notesString = style.FgYellow.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.NOTE_ICON, "✎")) + " "
+	}
+
 	name := commit.Name
 	if parseEmoji {
 		name = emoji.Sprint(name)
@@ -375,7 +389,7 @@ func displayCommit(
 		cols,
 		actionString,
 		authorFunc(commit.AuthorName),
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+tagString+signatureString+notesString+theme.DefaultTextColor.Sprint(name),
 	)
 
 	return cols
@@ -455,3 +469,16 @@ func actionColorMap(action todo.TodoCommand) style.TextStyle {
 		return style.FgYellow
 	}
 }
+
+func getSignatureMarker(status models.SignatureStatus) string {
+	switch status {
+	case models.SignatureStatusGood:
+		return style.FgGreen.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.SIGNATURE_GOOD_ICON, "✓"))
+	case models.SignatureStatusBad:
+		return style.FgRed.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.SIGNATURE_BAD_ICON, "✗"))
+	case models.SignatureStatusUnknown:
+		return style.FgYellow.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.SIGNATURE_UNKNOWN_ICON, "?"))
+	default:
+		return style.FgBlackLighter.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.UNSIGNED_ICON, "○"))
+	}
+}
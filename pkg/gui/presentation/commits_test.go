@@ -31,6 +31,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		branches                 []*models.Branch
 		currentBranchName        string
 		hasUpdateRefConfig       bool
+		showBranchStackNames     bool
 		fullDescription          bool
 		cherryPickedCommitShaSet *set.Set[string]
 		markedBaseCommit         string
@@ -93,6 +94,46 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 commit2
 						`),
 		},
+		{
+			testName: "commit with notes",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", Tags: []string{"tag1"}, HasNotes: true},
+				{Name: "commit2", Sha: "sha2"},
+			},
+			startIdx:                 0,
+			endIdx:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 tag1 ✎ commit1
+		sha2 commit2
+						`),
+		},
+		{
+			testName: "commits with verified signatures",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", Signature: &models.CommitSignature{Status: models.SignatureStatusGood}},
+				{Name: "commit2", Sha: "sha2", Signature: &models.CommitSignature{Status: models.SignatureStatusBad}},
+				{Name: "commit3", Sha: "sha3", Tags: []string{"tag3"}, HasNotes: true, Signature: &models.CommitSignature{Status: models.SignatureStatusUnknown}},
+				{Name: "commit4", Sha: "sha4", Signature: &models.CommitSignature{Status: models.SignatureStatusNone}},
+				{Name: "commit5", Sha: "sha5"},
+			},
+			startIdx:                 0,
+			endIdx:                   5,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 ✓ commit1
+		sha2 ✗ commit2
+		sha3 tag3 ? ✎ commit3
+		sha4 ○ commit4
+		sha5 commit5
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commits: []*models.Commit{
@@ -122,6 +163,34 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha4 commit4
 						`),
 		},
+		{
+			testName: "show the names of all branches of a stack if configured",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1"},
+				{Name: "commit2", Sha: "sha2"},
+				{Name: "commit3", Sha: "sha3"},
+			},
+			branches: []*models.Branch{
+				{Name: "current-branch", CommitHash: "sha1", Head: true},
+				{Name: "part-2", CommitHash: "sha2", Head: false},
+				{Name: "part-2-backup", CommitHash: "sha2", Head: false},
+				{Name: "part-1", CommitHash: "sha3", Head: false},
+			},
+			currentBranchName:        "current-branch",
+			hasUpdateRefConfig:       true,
+			showBranchStackNames:     true,
+			startIdx:                 0,
+			endIdx:                   3,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 commit1
+		sha2 * part-2 part-2-backup commit2
+		sha3 * part-1 commit3
+						`),
+		},
 		{
 			testName: "show local branch head for head commit if updateRefs is on",
 			commits: []*models.Commit{
@@ -400,6 +469,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		s := s
 		if !focusing || s.focus {
 			t.Run(s.testName, func(t *testing.T) {
+				common.UserConfig.Gui.ShowBranchStackNames = s.showBranchStackNames
 				result := GetCommitListDisplayStrings(
 					common,
 					s.commits,
@@ -153,8 +153,13 @@ func getFileLine(
 	isLinkedWorktree := file != nil && file.IsWorktree
 	isDirectory := file == nil
 
+	isLfs := file != nil && file.IsLfs
+
 	if showFileIcons {
 		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory)
+		if isLfs {
+			icon = icons.LFS_FILE_ICON
+		}
 		paint := color.C256(icon.Color, false)
 		output += paint.Sprint(icon.Icon) + nameColor.Sprint(" ")
 	}
@@ -165,6 +170,19 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	// without icons there's nothing else telling the user that the file is in LFS
+	if isLfs && !showFileIcons {
+		output += theme.DefaultTextColor.Sprint(" (LFS)")
+	}
+
+	if file != nil && file.IsOutsideSparseCheckout {
+		output += theme.DefaultTextColor.Sprint(" (outside sparse checkout)")
+	}
+
+	if file != nil && file.IsResolvedByRerere {
+		output += theme.DefaultTextColor.Sprint(" (resolved by rerere)")
+	}
+
 	return output
 }
 
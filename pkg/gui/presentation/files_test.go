@@ -41,6 +41,34 @@ func TestRenderFileTree(t *testing.T) {
 			},
 			expected: []string{" M test"},
 		},
+		{
+			name: "lfs file",
+			files: []*models.File{
+				{Name: "image.psd", ShortStatus: " M", HasStagedChanges: true, IsLfs: true},
+			},
+			expected: []string{" M image.psd (LFS)"},
+		},
+		{
+			name: "file outside sparse checkout",
+			files: []*models.File{
+				{Name: "dir/file", ShortStatus: "??", HasUnstagedChanges: true, IsOutsideSparseCheckout: true},
+				{Name: "file", ShortStatus: " M", HasUnstagedChanges: true},
+			},
+			expected: toStringSlice(
+				`
+▼ dir
+  ?? file (outside sparse checkout)
+ M file
+`,
+			),
+		},
+		{
+			name: "file resolved by rerere",
+			files: []*models.File{
+				{Name: "file", ShortStatus: "UU", HasUnstagedChanges: true, HasMergeConflicts: true, HasInlineMergeConflicts: true, IsResolvedByRerere: true},
+			},
+			expected: []string{"UU file (resolved by rerere)"},
+		},
 		{
 			name: "big example",
 			files: []*models.File{
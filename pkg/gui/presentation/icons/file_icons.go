@@ -9,6 +9,7 @@ var (
 	DEFAULT_FILE_ICON      = IconProperties{Icon: "\uf15b", Color: 241} // 
 	DEFAULT_SUBMODULE_ICON = IconProperties{Icon: "\uf1d3", Color: 202} // 
 	DEFAULT_DIRECTORY_ICON = IconProperties{Icon: "\uf07b", Color: 241} // 
+	LFS_FILE_ICON          = IconProperties{Icon: "\uf0c2", Color: 75}  // 
 )
 
 // See https://github.com/nvim-tree/nvim-web-devicons/blob/master/lua/nvim-web-devicons/icons-default.lua
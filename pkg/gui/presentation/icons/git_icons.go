@@ -16,6 +16,11 @@ var (
 	STASH_ICON                   = "\uf01c"     // 
 	LINKED_WORKTREE_ICON         = "\U000f0339" // 󰌹
 	MISSING_LINKED_WORKTREE_ICON = "\U000f033a" // 󰌺
+	NOTE_ICON                    = "\uf249"     // 
+	SIGNATURE_GOOD_ICON          = "\uf023"     // 
+	SIGNATURE_BAD_ICON           = "\uf071"     // 
+	SIGNATURE_UNKNOWN_ICON       = "\uf128"     // 
+	UNSIGNED_ICON                = "\uf09c"     // 
 )
 
 var remoteIcons = map[string]string{
@@ -0,0 +1,48 @@
+package presentation
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetRangeDiffPairListDisplayStrings(pairs []*models.RangeDiffPair) [][]string {
+	return lo.Map(pairs, func(pair *models.RangeDiffPair, _ int) []string {
+		return getRangeDiffPairDisplayStrings(pair)
+	})
+}
+
+// Mimics the output of `git range-diff`, i.e. the old commit in red, the new
+// commit in green, and a marker telling how they relate to each other
+func getRangeDiffPairDisplayStrings(pair *models.RangeDiffPair) []string {
+	return []string{
+		style.FgRed.Sprint(rangeDiffSide(pair.OldIndex, pair.OldSha)),
+		rangeDiffStatus(pair.Status),
+		style.FgGreen.Sprint(rangeDiffSide(pair.NewIndex, pair.NewSha)),
+		theme.DefaultTextColor.Sprint(pair.Name),
+	}
+}
+
+func rangeDiffSide(index int, sha string) string {
+	if sha == "" {
+		return "-: -------"
+	}
+
+	return fmt.Sprintf("%d: %s", index, sha)
+}
+
+func rangeDiffStatus(status models.RangeDiffStatus) string {
+	switch status {
+	case models.RangeDiffStatusModified:
+		return style.FgYellow.Sprint("!")
+	case models.RangeDiffStatusRemoved:
+		return style.FgRed.Sprint("<")
+	case models.RangeDiffStatusAdded:
+		return style.FgGreen.Sprint(">")
+	default:
+		return theme.DefaultTextColor.Sprint("=")
+	}
+}
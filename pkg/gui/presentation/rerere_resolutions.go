@@ -0,0 +1,32 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetRerereResolutionListDisplayStrings(resolutions []*models.RerereResolution, tr *i18n.TranslationSet) [][]string {
+	return lo.Map(resolutions, func(resolution *models.RerereResolution, _ int) []string {
+		return getRerereResolutionDisplayStrings(resolution, tr)
+	})
+}
+
+func getRerereResolutionDisplayStrings(resolution *models.RerereResolution, tr *i18n.TranslationSet) []string {
+	id := resolution.ShortHash() + resolution.FileSuffix()
+
+	status := style.FgGreen.Sprint(tr.RerereResolved)
+	if !resolution.HasPostimage {
+		status = style.FgRed.Sprint(tr.RerereUnresolved)
+	}
+
+	return []string{
+		style.FgYellow.Sprint(id),
+		style.FgCyan.Sprint(utils.UnixToTimeAgo(resolution.LastUsed.Unix())),
+		status,
+		theme.DefaultTextColor.Sprint(resolution.Summary),
+	}
+}
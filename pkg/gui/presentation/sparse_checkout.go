@@ -0,0 +1,13 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetSparseCheckoutDirectoryListDisplayStrings(dirs []*models.SparseCheckoutDirectory) [][]string {
+	return lo.Map(dirs, func(dir *models.SparseCheckoutDirectory, _ int) []string {
+		return []string{theme.DefaultTextColor.Sprint(dir.Path)}
+	})
+}
@@ -11,6 +11,8 @@ func FormatWorkingTreeStateTitle(tr *i18n.TranslationSet, rebaseMode enums.Rebas
 		return tr.RebasingStatus
 	case enums.REBASE_MODE_MERGING:
 		return tr.MergingStatus
+	case enums.REBASE_MODE_APPLYING:
+		return tr.ApplyingPatchesStatus
 	default:
 		// should never actually display this
 		return "none"
@@ -23,6 +25,8 @@ func FormatWorkingTreeStateLower(tr *i18n.TranslationSet, rebaseMode enums.Rebas
 		return tr.LowercaseRebasingStatus
 	case enums.REBASE_MODE_MERGING:
 		return tr.LowercaseMergingStatus
+	case enums.REBASE_MODE_APPLYING:
+		return tr.LowercaseApplyingPatchesStatus
 	default:
 		// should never actually display this
 		return "none"
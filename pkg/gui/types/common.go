@@ -259,6 +259,11 @@ type Model struct {
 	Remotes      []*models.Remote
 	Worktrees    []*models.Worktree
 
+	// IsSparseCheckout is false if the worktree has every file checked out, in
+	// which case SparseCheckoutDirectories is empty
+	IsSparseCheckout          bool
+	SparseCheckoutDirectories []*models.SparseCheckoutDirectory
+
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
 	// when in filtering mode we only include the ones that match the given path
 	FilteredReflogCommits []*models.Commit
@@ -280,6 +285,11 @@ type Model struct {
 	FilesTrie *patricia.Trie
 
 	Authors map[string]*models.Author
+
+	// Signatures of commits that we've already verified, by SHA. A commit's
+	// signature doesn't change, and verifying it is slow, so we only do it once.
+	// Only the commits in the commits panel are kept.
+	CommitSignatures map[string]*models.CommitSignature
 }
 
 // if you add a new mutex here be sure to instantiate it. We're using pointers to
@@ -3,17 +3,20 @@ package types
 import "github.com/jesseduffield/gocui"
 
 type Views struct {
-	Status         *gocui.View
-	Submodules     *gocui.View
-	Files          *gocui.View
-	Branches       *gocui.View
-	Remotes        *gocui.View
-	Worktrees      *gocui.View
-	Tags           *gocui.View
-	RemoteBranches *gocui.View
-	ReflogCommits  *gocui.View
-	Commits        *gocui.View
-	Stash          *gocui.View
+	Status            *gocui.View
+	Submodules        *gocui.View
+	Files             *gocui.View
+	Branches          *gocui.View
+	Remotes           *gocui.View
+	Worktrees         *gocui.View
+	SparseCheckout    *gocui.View
+	Tags              *gocui.View
+	RemoteBranches    *gocui.View
+	RangeDiff         *gocui.View
+	RerereResolutions *gocui.View
+	ReflogCommits     *gocui.View
+	Commits           *gocui.View
+	Stash             *gocui.View
 
 	Main                   *gocui.View
 	Secondary              *gocui.View
@@ -22,6 +25,11 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	MergeEditorBase        *gocui.View
+	MergeEditorOurs        *gocui.View
+	MergeEditorTheirs      *gocui.View
+	MergeEditorResult      *gocui.View
+	Blame                  *gocui.View
 
 	Options           *gocui.View
 	Confirmation      *gocui.View
@@ -27,6 +27,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Snake, name: "snake"},
 		{viewPtr: &gui.Views.Submodules, name: "submodules"},
 		{viewPtr: &gui.Views.Worktrees, name: "worktrees"},
+		{viewPtr: &gui.Views.SparseCheckout, name: "sparseCheckout"},
 		{viewPtr: &gui.Views.Files, name: "files"},
 		{viewPtr: &gui.Views.Tags, name: "tags"},
 		{viewPtr: &gui.Views.Remotes, name: "remotes"},
@@ -36,6 +37,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Commits, name: "commits"},
 		{viewPtr: &gui.Views.Stash, name: "stash"},
 		{viewPtr: &gui.Views.SubCommits, name: "subCommits"},
+		{viewPtr: &gui.Views.RangeDiff, name: "rangeDiff"},
+		{viewPtr: &gui.Views.RerereResolutions, name: "rerereResolutions"},
 		{viewPtr: &gui.Views.CommitFiles, name: "commitFiles"},
 
 		{viewPtr: &gui.Views.Staging, name: "staging"},
@@ -43,6 +46,11 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.MergeEditorBase, name: "mergeEditorBase"},
+		{viewPtr: &gui.Views.MergeEditorOurs, name: "mergeEditorOurs"},
+		{viewPtr: &gui.Views.MergeEditorTheirs, name: "mergeEditorTheirs"},
+		{viewPtr: &gui.Views.MergeEditorResult, name: "mergeEditorResult"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -119,10 +127,16 @@ func (gui *Gui) createAllViews() error {
 
 	gui.Views.Branches.Title = gui.c.Tr.BranchesTitle
 
+	gui.Views.RangeDiff.Title = gui.c.Tr.RangeDiffTitle
+
+	gui.Views.RerereResolutions.Title = gui.c.Tr.RerereResolutionsTitle
+
 	gui.Views.Remotes.Title = gui.c.Tr.RemotesTitle
 
 	gui.Views.Worktrees.Title = gui.c.Tr.WorktreesTitle
 
+	gui.Views.SparseCheckout.Title = gui.c.Tr.SparseCheckoutTitle
+
 	gui.Views.Tags.Title = gui.c.Tr.TagsTitle
 
 	gui.Views.Files.Title = gui.c.Tr.FilesTitle
@@ -149,6 +163,22 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.MergeConflicts.Title = gui.c.Tr.MergeConflictsTitle
 	gui.Views.MergeConflicts.Wrap = false
 
+	gui.Views.MergeEditorBase.Title = gui.c.Tr.MergeEditorBaseTitle
+	gui.Views.MergeEditorOurs.Title = gui.c.Tr.MergeEditorOursTitle
+	gui.Views.MergeEditorTheirs.Title = gui.c.Tr.MergeEditorTheirsTitle
+	for _, view := range []*gocui.View{gui.Views.MergeEditorBase, gui.Views.MergeEditorOurs, gui.Views.MergeEditorTheirs} {
+		view.Wrap = false
+		view.IgnoreCarriageReturns = true
+	}
+
+	gui.Views.MergeEditorResult.Title = gui.c.Tr.MergeEditorResultTitle
+	gui.Views.MergeEditorResult.FgColor = theme.GocuiDefaultTextColor
+	gui.Views.MergeEditorResult.Editable = true
+	gui.Views.MergeEditorResult.Editor = gocui.EditorFunc(gui.mergeEditorResultEditor)
+
+	gui.Views.Blame.Title = gui.c.Tr.BlameTitle
+	gui.Views.Blame.Wrap = false
+
 	gui.Views.Limit.Title = gui.c.Tr.NotEnoughSpace
 	gui.Views.Limit.Wrap = true
 
@@ -201,6 +231,7 @@ func (gui *Gui) createAllViews() error {
 		gui.Views.Files.TitlePrefix = jumpLabels[1]
 		gui.Views.Worktrees.TitlePrefix = jumpLabels[1]
 		gui.Views.Submodules.TitlePrefix = jumpLabels[1]
+		gui.Views.SparseCheckout.TitlePrefix = jumpLabels[1]
 
 		gui.Views.Branches.TitlePrefix = jumpLabels[2]
 		gui.Views.Remotes.TitlePrefix = jumpLabels[2]
@@ -657,6 +657,316 @@ type TranslationSet struct {
 	RangeSelectNotSupported             string
 	NoItemSelected                      string
 	SelectedItemIsNotABranch            string
+	BlameTitle                          string
+	BlameDynamicTitle                   string
+	BlameCheatsheetTitle                string
+	OpenBlame                           string
+	OpenBlameTooltip                    string
+	GoToBlamedCommit                    string
+	GoToBlamedCommitTooltip             string
+	BlamePreviousRevision               string
+	BlamePreviousRevisionTooltip        string
+	LineNotCommittedYet                 string
+	NoPreviousRevision                  string
+	BlamedCommitNotInCurrentBranch      string
+	LoadingBlame                        string
+	LoadingRangeDiff                    string
+	CannotBlameDirectory                string
+	CannotBlameUntrackedFile            string
+	CannotBlameDeletedFile              string
+	ViewLineRangeHistory                string
+	ViewLineRangeHistoryTooltip         string
+	LineHistoryNewFile                  string
+	LineHistoryDeletedFile              string
+	FilterAuthorOption                  string
+	FilterMessageOption                 string
+	FilterSinceOption                   string
+	FilterUntilOption                   string
+	FilterContentOption                 string
+	FilterContentRegexOption            string
+	EnterAuthor                         string
+	EnterCommitMessageFilter            string
+	EnterSinceDate                      string
+	EnterUntilDate                      string
+	EnterContentFilter                  string
+	EnterContentRegexFilter             string
+	FilterAuthorLabel                   string
+	FilterMessageLabel                  string
+	FilterSinceLabel                    string
+	FilterUntilLabel                    string
+	FilterContentLabel                  string
+	FilterContentRegexLabel             string
+	OpenNotesMenu                       string
+	OpenNotesMenuTooltip                string
+	NotesMenuTitle                      string
+	AddOrEditNote                       string
+	RemoveNote                          string
+	NoNoteToRemove                      string
+	PushNotes                           string
+	FetchNotes                          string
+	PushNotesTitle                      string
+	FetchNotesTitle                     string
+	PushingNotesStatus                  string
+	FetchingNotesStatus                 string
+	CannotAttachNoteToTodo              string
+	OpenLfsMenu                         string
+	OpenLfsMenuTooltip                  string
+	LfsMenuTitle                        string
+	LfsLockFile                         string
+	LfsUnlockFile                       string
+	LfsViewLocks                        string
+	LfsLocksTitle                       string
+	LfsNoLocks                          string
+	LfsUnlock                           string
+	LfsForceUnlock                      string
+	LfsForceUnlockTooltip               string
+	LfsNotUsedInRepo                    string
+	LfsLoadingLocksStatus               string
+	LfsLockingStatus                    string
+	LfsUnlockingStatus                  string
+	LfsObjectTitle                      string
+	LfsOldObject                        string
+	LfsNewObject                        string
+	LfsNoObject                         string
+	LfsWorkingTreeObject                string
+	LargeFilesWarningTitle              string
+	LargeFilesWarningPrompt             string
+	LfsNoFileSelected                   string
+	ViewRangeDiffOptions                string
+	ViewRangeDiffOptionsTooltip         string
+	RangeDiffOptionsTitle               string
+	RangeDiffAgainst                    string
+	RangeDiffAgainstReflogEntry         string
+	RangeDiffAgainstRef                 string
+	DiffingRefGenericName               string
+	NotInDiffingMode                    string
+	NoPreviousBranchVersions            string
+	RangeDiffNoCommits                  string
+	RangeDiffTitle                      string
+	RangeDiffDynamicTitle               string
+	ApplyingPatchesStatus               string
+	LowercaseApplyingPatchesStatus      string
+	ApplyPatchesOptionsTitle            string
+	OpenPatchFilesMenu                  string
+	OpenPatchFilesMenuTooltip           string
+	PatchFilesMenuTitle                 string
+	ExportPatchesToDirectory            string
+	CopyPatchesAsMbox                   string
+	ApplyPatchesWithAm                  string
+	ApplyPatchesWithAmTooltip           string
+	CannotExportTodoCommits             string
+	CannotApplyPatchesMidOperation      string
+	ExportPatchesDirectoryTitle         string
+	DirectoryRequired                   string
+	ExportingPatchesStatus              string
+	PatchesExported                     string
+	PatchesCopiedToClipboard            string
+	ApplyPatchesPathTitle               string
+	PatchFilePathRequired               string
+	StashSelectedFiles                  string
+	StashSelectedFilesTooltip           string
+	StashSelection                      string
+	StashSelectionTooltip               string
+	BranchFromStash                     string
+	BranchFromStashTooltip              string
+	BranchFromStashPrompt               string
+	SparseCheckoutTitle                 string
+	AddSparseCheckoutDirectory          string
+	AddSparseCheckoutDirectoryPrompt    string
+	AddToSparseCheckoutTooltip          string
+	RootAlwaysInSparseCheckout          string
+	EnableSparseCheckoutTitle           string
+	EnableSparseCheckoutPrompt          string
+	RemoveSparseCheckoutDirectory       string
+	RemoveSparseCheckoutDirectoryPrompt string
+	ReapplySparseCheckout               string
+	ReapplySparseCheckoutTooltip        string
+	DisableSparseCheckout               string
+	DisableSparseCheckoutTooltip        string
+	NotASparseCheckout                  string
+	SparseCheckoutOfRootOnly            string
+	OpenRerereMenu                      string
+	OpenRerereMenuTooltip               string
+	RerereMenuTitle                     string
+	RerereNotEnabled                    string
+	ForgetRerereResolution              string
+	ForgetRerereResolutionTooltip       string
+	ForgetRerereNeedsConflict           string
+	ViewRerereResolutions               string
+	RerereResolutionsTitle              string
+	RerereResolutionTitle               string
+	NoRerereResolutions                 string
+	RerereResolved                      string
+	RerereUnresolved                    string
+	RerereNoResolutionRecorded          string
+	DeleteRerereResolution              string
+	DeleteRerereResolutionTooltip       string
+	DeleteRerereResolutionPrompt        string
+	ResolvedByRerereTitle               string
+	StageRerereResolution               string
+	StageRerereResolutionTooltip        string
+	FilterUnsignedOption                string
+	FilterUnsignedOptionTooltip         string
+	ShowSignedCommitsToo                string
+	FilterUnsignedLabel                 string
+	GoodSignature                       string
+	BadSignature                        string
+	UnknownSignature                    string
+	SignatureSigner                     string
+	SignatureKey                        string
+	MergeEditorBaseTitle                string
+	MergeEditorOursTitle                string
+	MergeEditorTheirsTitle              string
+	MergeEditorResultTitle              string
+	MergeEditorCheatsheetTitle          string
+	OpenMergeEditor                     string
+	OpenMergeEditorTooltip              string
+	ShowConflictBase                    string
+	ShowConflictBaseTooltip             string
+	ShowConflictBasePrompt              string
+	MergeEditorNoBase                   string
+	MergeEditorPickLines                string
+	MergeEditorPickLinesTooltip         string
+	MergeEditorApply                    string
+	MergeEditorApplyTooltip             string
+	MergeEditorApplyEmptyPrompt         string
+	MergeEditorClose                    string
+	MergeEditorNextPane                 string
+	MergeEditorConflictPosition         string
+	ConflictBothModified                string
+	ConflictBothAdded                   string
+	ConflictBothDeleted                 string
+	ConflictAddedByUs                   string
+	ConflictAddedByThem                 string
+	ConflictDeletedByUs                 string
+	ConflictDeletedByThem               string
+	ConflictModifiedBinaryExplanation   string
+	ConflictBothAddedBinaryExplanation  string
+	ConflictSubmoduleExplanation        string
+	ConflictBothDeletedExplanation      string
+	ConflictAddedByUsExplanation        string
+	ConflictAddedByThemExplanation      string
+	ConflictDeletedByUsExplanation      string
+	ConflictDeletedByThemExplanation    string
+	ResolveWholeFileConflictHint        string
+	KeepOurVersion                      string
+	KeepTheirVersion                    string
+	CheckoutOursTooltip                 string
+	CheckoutTheirsTooltip               string
+	KeepFileTooltip                     string
+	KeepDeleted                         string
+	DeleteConflictedFile                string
+	RemoveConflictedFileTooltip         string
+	StageSubmoduleCommit                string
+	StageSubmoduleCommitTooltip         string
+	SavePatchToFile                     string
+	SavePatchPrompt                     string
+	PatchSaved                          string
+	ApplyPatchFromFile                  string
+	ApplyPatchFromFileTooltip           string
+	ApplyPatchFromClipboard             string
+	ApplyPatchFromClipboardTooltip      string
+	ApplyPatchFilePrompt                string
+	NoPatchInClipboard                  string
+	ApplyPatchTo                        string
+	ApplyPatchToWorkingTree             string
+	ApplyPatchToIndex                   string
+	ApplyPatchToIndexTooltip            string
+	PatchDoesNotApplyTitle              string
+	PatchDoesNotApplyPrompt             string
+	PatchAppliedWithConflicts           string
+	SplitHunk                           string
+	SplitHunkTooltip                    string
+	CannotSplitHunk                     string
+	SkipHunk                            string
+	SkipHunkTooltip                     string
+	OnlyWhileStagingHunksOneByOne       string
+	StageHunksOneByOne                  string
+	StageHunksOneByOneTooltip           string
+	NoUnstagedHunks                     string
+	NoMoreHunksToStage                  string
+	AddTrailer                          string
+	ConventionalCommitTypeTitle         string
+	ConventionalCommitScopeTitle        string
+	ConventionalCommitSummaryError      string
+	CommitLintSummaryLength             string
+	CommitLintBodyLineLength            string
+	CommitLintSummaryTrailingPeriod     string
+	CommitLintTicketReference           string
+	CommitLintBlankLineAfterSummary     string
+	CommitLintTicketPatternError        string
+	CommitLintBlockedError              string
+	Absorb                              string
+	AbsorbTooltip                       string
+	AbsorbTitle                         string
+	AbsorbCreateFixupCommits            string
+	AbsorbCreateFixupCommitsAndSquash   string
+	AbsorbPlan                          string
+	AbsorbNoStagedChanges               string
+	AbsorbWhileRebasingError            string
+	AbsorbNothingAssigned               string
+	AbsorbUnassignedHunks               string
+	AbsorbHunkSeveralCommits            string
+	AbsorbHunkNotOnBranch               string
+	AbsorbHunkUnknownCommit             string
+	AbsorbHunkUnsupportedFile           string
+	AbsorbingStatus                     string
+	FindingAbsorbTargetsStatus          string
+	SplitCommit                         string
+	SplitCommitTooltip                  string
+	CantSplitMergeCommit                string
+	CantSplitFirstCommit                string
+	CommitSplitPart                     string
+	CommitSplitPartTooltip              string
+	NotSplittingCommit                  string
+	SplitPartNotInPatch                 string
+	SplitCommitPartTitle                string
+	SplittingCommitStatus               string
+	SplittingCommitMode                 string
+	MoveCommitsToBranch                 string
+	MoveCommitsToBranchTooltip          string
+	MoveCommitsToNewBranch              string
+	MoveCommitsToNewBranchTooltip       string
+	MoveCommitsToExistingBranch         string
+	MoveCommitsToExistingBranchTooltip  string
+	MoveCommitsToBranchTitle            string
+	MoveCommitsNewBranchPrompt          string
+	MoveCommitsExistingBranchPrompt     string
+	MoveCommitsBranchDoesNotExist       string
+	MoveCommitsToCurrentBranch          string
+	MoveCommitsBranchCheckedOut         string
+	CantMoveFirstCommitToExistingBranch string
+	CantMoveMergeCommitsToBranch        string
+	MovingCommitsStatus                 string
+	RebaseStackRequiresNewerGit         string
+	MoveToBranchRequiresNewerGit        string
+	BranchStack                         string
+	BranchStackTooltip                  string
+	BranchStackDescription              string
+	NoBranchStack                       string
+	RebaseStackOnto                     string
+	PushBranchStack                     string
+	PushingBranchStackStatus            string
+	PushBranchStackResultsTitle         string
+	PushBranchStackSucceeded            string
+	PushBranchStackFailed               string
+	PushBranchStackSkipped              string
+	ConflictPreviewRequiresNewerGit     string
+	PreviewConflicts                    string
+	PreviewConflictsTooltip             string
+	PreviewMerge                        string
+	PreviewRebase                       string
+	CantPreviewConflictsWithSelf        string
+	MergePreviewTitle                   string
+	RebasePreviewTitle                  string
+	ConflictPreviewSummaryTitle         string
+	CheckingForConflictsStatus          string
+	MergePreviewNoConflicts             string
+	MergePreviewConflicts               string
+	RebasePreviewNoConflicts            string
+	RebasePreviewConflicts              string
+	UserIdentityNotConfigured           string
+	LineHistoryPartlyStaged             string
 	Actions                             Actions
 	Bisect                              Bisect
 	Log                                 Log
@@ -680,6 +990,19 @@ type Bisect struct {
 	CompletePrompt              string
 	CompletePromptIndeterminate string
 	Bisecting                   string
+	Run                         string
+	RunTooltip                  string
+	RunPrompt                   string
+	RunNeedsBothTerms           string
+	Running                     string
+	RunStopped                  string
+	SaveLog                     string
+	SaveLogTooltip              string
+	SaveLogPrompt               string
+	SavedLog                    string
+	ReplayLog                   string
+	ReplayLogTooltip            string
+	ReplayLogPrompt             string
 }
 
 type Log struct {
@@ -809,6 +1132,39 @@ type Actions struct {
 	BisectMark                        string
 	RemoveWorktree                    string
 	AddWorktree                       string
+	EditNote                          string
+	RemoveNote                        string
+	PushNotes                         string
+	FetchNotes                        string
+	LfsLockFile                       string
+	LfsUnlockFile                     string
+	ExportPatches                     string
+	CopyPatchesToClipboard            string
+	ApplyPatches                      string
+	StashSelectedFiles                string
+	StashSelection                    string
+	BranchFromStash                   string
+	AddSparseCheckoutDirectory        string
+	RemoveSparseCheckoutDirectory     string
+	ReapplySparseCheckout             string
+	DisableSparseCheckout             string
+	ForgetRerereResolution            string
+	DeleteRerereResolution            string
+	ResolveConflictInMergeEditor      string
+	ShowConflictBase                  string
+	CheckoutConflictVersion           string
+	RemoveConflictedFile              string
+	BisectRun                         string
+	BisectSaveLog                     string
+	BisectReplayLog                   string
+	SavePatchToFile                   string
+	ApplyPatchFile                    string
+	Absorb                            string
+	SplitCommit                       string
+	CommitSplitPart                   string
+	MoveCommitsToBranch               string
+	RebaseBranchStack                 string
+	PushBranchStack                   string
 }
 
 const englishIntroPopupMessage = `
@@ -1289,14 +1645,14 @@ func EnglishTranslationSet() TranslationSet {
 		GotoBottom:                       "Scroll to bottom",
 		FilteringBy:                      "Filtering by",
 		ResetInParentheses:               "(Reset)",
-		OpenFilteringMenu:                "View filter-by-path options",
+		OpenFilteringMenu:                "View filter options",
 		FilterBy:                         "Filter by",
-		ExitFilterMode:                   "Stop filtering by path",
+		ExitFilterMode:                   "Stop filtering",
 		FilterPathOption:                 "Enter path to filter by",
 		EnterFileName:                    "Enter path:",
 		FilteringMenuTitle:               "Filtering",
 		MustExitFilterModeTitle:          "Command not available",
-		MustExitFilterModePrompt:         "Command not available in filter-by-path mode. Exit filter-by-path mode?",
+		MustExitFilterModePrompt:         "Command not available in filter mode. Exit filter mode?",
 		Diff:                             "Diff",
 		EnterRefToDiff:                   "Enter ref to diff",
 		EnterRefName:                     "Enter ref:",
@@ -1493,6 +1849,316 @@ func EnglishTranslationSet() TranslationSet {
 		RangeSelectNotSupported:             "Action does not support range selection, please select a single item",
 		NoItemSelected:                      "No item selected",
 		SelectedItemIsNotABranch:            "Selected item is not a branch",
+		BlameTitle:                          "Blame",
+		BlameDynamicTitle:                   "Blame (%s)",
+		BlameCheatsheetTitle:                "Main panel (blame)",
+		OpenBlame:                           "View blame",
+		OpenBlameTooltip:                    "Show, for each line of the selected file, the commit that last changed it. Press enter on a line to jump to that commit in the commits panel.",
+		GoToBlamedCommit:                    "Go to commit",
+		GoToBlamedCommitTooltip:             "Select the commit that last changed this line in the commits panel.",
+		BlamePreviousRevision:               "Blame previous revision",
+		BlamePreviousRevisionTooltip:        "Blame the file as it was just before the commit that last changed the selected line, to see how the line looked before that.",
+		LineNotCommittedYet:                 "This line has not been committed yet",
+		NoPreviousRevision:                  "There is no earlier revision of this line; it was added in the commit that created the file",
+		BlamedCommitNotInCurrentBranch:      "The commit that last changed this line is not part of the current branch",
+		LoadingBlame:                        "Loading blame",
+		LoadingRangeDiff:                    "Loading range diff",
+		CannotBlameDirectory:                "Cannot blame a directory",
+		CannotBlameUntrackedFile:            "Cannot blame a file that is not tracked by git",
+		CannotBlameDeletedFile:              "Cannot blame a deleted file",
+		ViewLineRangeHistory:                "View history of selected lines",
+		ViewLineRangeHistoryTooltip:         "Show the commits that changed the selected lines (using `git log -L`). Each commit's diff is limited to those lines.",
+		LineHistoryNewFile:                  "Cannot view the history of lines in a file that has not been committed yet",
+		LineHistoryDeletedFile:              "Cannot view the history of lines in a file that was deleted by this commit",
+		FilterAuthorOption:                  "Enter author to filter by",
+		FilterMessageOption:                 "Enter text to search commit messages for",
+		FilterSinceOption:                   "Only show commits since date",
+		FilterUntilOption:                   "Only show commits until date",
+		FilterContentOption:                 "Enter text added or removed by the commits (-S)",
+		FilterContentRegexOption:            "Enter regex matching lines changed by the commits (-G)",
+		EnterAuthor:                         "Author:",
+		EnterCommitMessageFilter:            "Commit message contains (regex):",
+		EnterSinceDate:                      "Since (e.g. '2 weeks ago' or '2024-01-31'):",
+		EnterUntilDate:                      "Until (e.g. 'yesterday' or '2024-01-31'):",
+		EnterContentFilter:                  "Text:",
+		EnterContentRegexFilter:             "Regex:",
+		FilterAuthorLabel:                   "author",
+		FilterMessageLabel:                  "message",
+		FilterSinceLabel:                    "since",
+		FilterUntilLabel:                    "until",
+		FilterContentLabel:                  "content",
+		FilterContentRegexLabel:             "content regex",
+		OpenNotesMenu:                       "View notes options",
+		OpenNotesMenuTooltip:                "View options for the git notes attached to the selected commit, or for pushing and fetching notes.",
+		NotesMenuTitle:                      "Notes",
+		AddOrEditNote:                       "Add/edit note",
+		RemoveNote:                          "Remove note",
+		NoNoteToRemove:                      "The selected commit has no note to remove.",
+		PushNotes:                           "Push notes",
+		FetchNotes:                          "Fetch notes",
+		PushNotesTitle:                      "Remote to push notes to:",
+		FetchNotesTitle:                     "Remote to fetch notes from:",
+		PushingNotesStatus:                  "Pushing notes",
+		FetchingNotesStatus:                 "Fetching notes",
+		CannotAttachNoteToTodo:              "You cannot attach a note to a commit that hasn't been rebased yet.",
+		OpenLfsMenu:                         "View Git LFS options",
+		OpenLfsMenuTooltip:                  "Lock or unlock the selected file on the Git LFS server, or view the files that are currently locked.",
+		LfsMenuTitle:                        "Git LFS",
+		LfsLockFile:                         "Lock file",
+		LfsUnlockFile:                       "Unlock file",
+		LfsViewLocks:                        "View locks",
+		LfsLocksTitle:                       "Git LFS locks",
+		LfsNoLocks:                          "No files are locked",
+		LfsUnlock:                           "Unlock",
+		LfsForceUnlock:                      "Force unlock",
+		LfsForceUnlockTooltip:               "Release the lock even if it is held by someone else.",
+		LfsNotUsedInRepo:                    "This repository doesn't use Git LFS.",
+		LfsLoadingLocksStatus:               "Loading locks",
+		LfsLockingStatus:                    "Locking",
+		LfsUnlockingStatus:                  "Unlocking",
+		LfsObjectTitle:                      "Git LFS object: %s",
+		LfsOldObject:                        "old",
+		LfsNewObject:                        "new",
+		LfsNoObject:                         "none",
+		LfsWorkingTreeObject:                "%s in working tree",
+		LargeFilesWarningTitle:              "Large files",
+		LargeFilesWarningPrompt:             "The following files are larger than %s and aren't tracked by Git LFS:\n\n%s\n\nAre you sure you want to commit them?",
+		LfsNoFileSelected:                   "Select a file to lock or unlock.",
+		ViewRangeDiffOptions:                "View range-diff options",
+		ViewRangeDiffOptionsTooltip:         "Compare the commits of the selected branch with those of another version of it, e.g. its upstream before a force-push or its state before a rebase. Each commit is matched up with its counterpart, and the main view shows how the two differ.",
+		RangeDiffOptionsTitle:               "Range-diff for '%s'",
+		RangeDiffAgainst:                    "Compare with %s",
+		RangeDiffAgainstReflogEntry:         "Compare with earlier version from branch reflog",
+		RangeDiffAgainstRef:                 "Compare with ref...",
+		DiffingRefGenericName:               "ref being diffed",
+		NotInDiffingMode:                    "Not in diffing mode",
+		NoPreviousBranchVersions:            "The branch reflog doesn't contain any earlier versions of this branch",
+		RangeDiffNoCommits:                  "No commits to compare between %s and %s",
+		RangeDiffTitle:                      "Range diff",
+		RangeDiffDynamicTitle:               "Range diff (%s)",
+		ApplyingPatchesStatus:               "Applying patches",
+		LowercaseApplyingPatchesStatus:      "applying patches",
+		ApplyPatchesOptionsTitle:            "Apply patches options",
+		OpenPatchFilesMenu:                  "View patch file options",
+		OpenPatchFilesMenuTooltip:           "Export the selected commits as patch files (git format-patch), or apply patch files as new commits (git am).",
+		PatchFilesMenuTitle:                 "Patch files",
+		ExportPatchesToDirectory:            "Save selected commits as patch files in directory",
+		CopyPatchesAsMbox:                   "Copy selected commits as mbox to clipboard",
+		ApplyPatchesWithAm:                  "Apply patch file or mbox",
+		ApplyPatchesWithAmTooltip:           "Create a commit for each patch in the given file using git am. If a patch doesn't apply cleanly, you can resolve the conflicts and continue, skip the patch, or abort from the merge/rebase options menu.",
+		CannotExportTodoCommits:             "Can't export commits that haven't been rebased yet",
+		CannotApplyPatchesMidOperation:      "Can't apply patches while a rebase, merge or patch application is in progress",
+		ExportPatchesDirectoryTitle:         "Directory to save patch files to:",
+		DirectoryRequired:                   "Please enter a directory",
+		ExportingPatchesStatus:              "Exporting patches",
+		PatchesExported:                     "Saved %d patch file(s) to %s",
+		PatchesCopiedToClipboard:            "Patches copied to clipboard",
+		ApplyPatchesPathTitle:               "Path of patch file or mbox to apply:",
+		PatchFilePathRequired:               "Please enter the path of a patch file",
+		StashSelectedFiles:                  "Stash selected files",
+		StashSelectedFilesTooltip:           "Stash the changes of the selected files or directories, including untracked ones, and leave all other changes in place.",
+		StashSelection:                      "Stash selection",
+		StashSelectionTooltip:               "Stash the selected lines or hunk and leave all other changes in place. The stash entry contains nothing but the selected changes.",
+		BranchFromStash:                     "Create branch from stash",
+		BranchFromStashTooltip:              "Check out a new branch at the commit the stash entry was created from, and apply the stash entry there (`git stash branch`). The stash entry is dropped if it applies cleanly.",
+		BranchFromStashPrompt:               "New branch name (checked out at the base of '{{.stashName}}')",
+		SparseCheckoutTitle:                 "Sparse checkout",
+		AddSparseCheckoutDirectory:          "Add directory to sparse checkout",
+		AddSparseCheckoutDirectoryPrompt:    "Directory to add to the sparse checkout:",
+		AddToSparseCheckoutTooltip:          "Add the selected directory, or the directory of the selected file, to the sparse checkout so that all of its files are checked out.",
+		RootAlwaysInSparseCheckout:          "Files in the root directory are always checked out.",
+		EnableSparseCheckoutTitle:           "Enable sparse checkout",
+		EnableSparseCheckoutPrompt:          "This worktree is not a sparse checkout yet. Only '{{.path}}' and the files in the root directory will stay checked out. Continue?",
+		RemoveSparseCheckoutDirectory:       "Remove directory from sparse checkout",
+		RemoveSparseCheckoutDirectoryPrompt: "Are you sure you want to remove '{{.path}}' from the sparse checkout? Its files will be removed from the working tree.",
+		ReapplySparseCheckout:               "Reapply sparse checkout",
+		ReapplySparseCheckoutTooltip:        "Update the working tree to match the sparse checkout again, e.g. after resolving conflicts in files outside of it. If sparse checkout has been disabled, this enables it again with the directories it had before.",
+		DisableSparseCheckout:               "Disable sparse checkout",
+		DisableSparseCheckoutTooltip:        "Check out all files again. The directories are remembered, so you can go back to the sparse checkout by reapplying it.",
+		NotASparseCheckout:                  "This worktree is not a sparse checkout, so all files are checked out.",
+		SparseCheckoutOfRootOnly:            "Only the files in the root directory are checked out.",
+		OpenRerereMenu:                      "View rerere options",
+		OpenRerereMenuTooltip:               "Rerere (reuse recorded resolution) makes git remember how you resolved conflicts, and resolve the same conflicts automatically next time. From this menu you can forget a bad recorded resolution, or browse the recorded resolutions.",
+		RerereMenuTitle:                     "Rerere",
+		RerereNotEnabled:                    "Rerere is not enabled in this repo. Set rerere.enabled to true in your git config to turn it on.",
+		ForgetRerereResolution:              "Forget recorded resolution",
+		ForgetRerereResolutionTooltip:       "Forget how this conflict was resolved before and restore the conflict markers, so that you can resolve it again. The new resolution will be recorded instead.",
+		ForgetRerereNeedsConflict:           "The selected file has no conflicts",
+		ViewRerereResolutions:               "Browse recorded resolutions",
+		RerereResolutionsTitle:              "Recorded resolutions",
+		RerereResolutionTitle:               "Resolution",
+		NoRerereResolutions:                 "There are no recorded resolutions",
+		RerereResolved:                      "resolved",
+		RerereUnresolved:                    "unresolved",
+		RerereNoResolutionRecorded:          "This conflict was recorded, but no resolution has been recorded for it yet.",
+		DeleteRerereResolution:              "Delete recorded resolution",
+		DeleteRerereResolutionTooltip:       "Delete this resolution from the rerere cache, so that the conflict won't be resolved automatically any more.",
+		DeleteRerereResolutionPrompt:        "Are you sure you want to delete the recorded resolution '{{.id}}'?",
+		ResolvedByRerereTitle:               "'{{.path}}' was resolved by rerere",
+		StageRerereResolution:               "Stage resolution",
+		StageRerereResolutionTooltip:        "Git resolved the conflicts in this file automatically, using the resolution you recorded the last time the same conflicts came up. Check the diff, then stage the file to mark it as resolved.",
+		FilterUnsignedOption:                "Show only unsigned commits",
+		FilterUnsignedOptionTooltip:         "Verifies the signature of every commit, which can be slow in large repos.",
+		ShowSignedCommitsToo:                "Show signed commits too",
+		FilterUnsignedLabel:                 "unsigned commits",
+		GoodSignature:                       "Good signature",
+		BadSignature:                        "Bad signature",
+		UnknownSignature:                    "Signature of unknown validity",
+		SignatureSigner:                     "Signer",
+		SignatureKey:                        "Key",
+		MergeEditorBaseTitle:                "Base",
+		MergeEditorOursTitle:                "Ours",
+		MergeEditorTheirsTitle:              "Theirs",
+		MergeEditorResultTitle:              "Result",
+		MergeEditorCheatsheetTitle:          "Merge editor",
+		OpenMergeEditor:                     "Open merge editor",
+		OpenMergeEditorTooltip:              "Resolve the selected conflict in an editor showing our version, their version and the base version side by side. Pick lines from them into the result, or edit the result directly.",
+		ShowConflictBase:                    "Show base version of conflicts",
+		ShowConflictBaseTooltip:             "Write the conflicts of the file again in zdiff3 style, so that they include the version that both sides are based on.",
+		ShowConflictBasePrompt:              "This will rewrite the conflicts in '{{.path}}' so that they include the base version. Conflicts you've already resolved in this file will come back. Continue?",
+		MergeEditorNoBase:                   "No base version. Press {{.key}} to show it.",
+		MergeEditorPickLines:                "Pick lines",
+		MergeEditorPickLinesTooltip:         "Append the selected lines to the result.",
+		MergeEditorApply:                    "Apply resolution",
+		MergeEditorApplyTooltip:             "Replace the conflict in the file with the result and move on to the next conflict.",
+		MergeEditorApplyEmptyPrompt:         "The result is empty, so the conflict will be removed without keeping any of its lines. Continue?",
+		MergeEditorClose:                    "Return to merge conflicts view",
+		MergeEditorNextPane:                 "Switch to next pane",
+		MergeEditorConflictPosition:         "conflict {{.current}} of {{.total}}",
+		ConflictBothModified:                "Both modified",
+		ConflictBothAdded:                   "Both added",
+		ConflictBothDeleted:                 "Both deleted",
+		ConflictAddedByUs:                   "Added by us",
+		ConflictAddedByThem:                 "Added by them",
+		ConflictDeletedByUs:                 "Deleted by us",
+		ConflictDeletedByThem:               "Deleted by them",
+		ConflictModifiedBinaryExplanation:   "Both sides changed this binary file, so git can't merge their changes. The working tree has our version.",
+		ConflictBothAddedBinaryExplanation:  "Both sides added this binary file with different content, so git can't merge them. The working tree has our version.",
+		ConflictSubmoduleExplanation:        "Both sides changed which commit this submodule points to. Enter the submodule and check out the commit you want, then stage the submodule.",
+		ConflictBothDeletedExplanation:      "Both sides deleted this file, e.g. because each of them renamed it to a different name. Look for the files that were added by us and by them to see where it went.",
+		ConflictAddedByUsExplanation:        "This file only exists on our side, e.g. because we renamed a file that their side deleted or renamed to a different name.",
+		ConflictAddedByThemExplanation:      "This file only exists on their side, e.g. because they renamed a file that our side deleted or renamed to a different name.",
+		ConflictDeletedByUsExplanation:      "We deleted this file, but their side changed it. The working tree has their version.",
+		ConflictDeletedByThemExplanation:    "Their side deleted this file, but we changed it. The working tree has our version.",
+		ResolveWholeFileConflictHint:        "Press {{.key}} to choose how to resolve the conflict.",
+		KeepOurVersion:                      "Keep our version",
+		KeepTheirVersion:                    "Keep their version",
+		CheckoutOursTooltip:                 "Replace the file with our version of it (git checkout --ours) and stage it.",
+		CheckoutTheirsTooltip:               "Replace the file with their version of it (git checkout --theirs) and stage it.",
+		KeepFileTooltip:                     "Stage the file as it is in the working tree.",
+		KeepDeleted:                         "Keep deleted",
+		DeleteConflictedFile:                "Delete file",
+		RemoveConflictedFileTooltip:         "Delete the file and stage its deletion (git rm).",
+		StageSubmoduleCommit:                "Stage submodule's current commit",
+		StageSubmoduleCommitTooltip:         "Resolve the conflict with the commit that is currently checked out in the submodule.",
+		SavePatchToFile:                     "Save patch to file",
+		SavePatchPrompt:                     "Save patch to:",
+		PatchSaved:                          "Saved patch to '{{.path}}'",
+		ApplyPatchFromFile:                  "Apply patch from file",
+		ApplyPatchFromFileTooltip:           "Apply a patch file, e.g. one saved in another clone of this repo, to the working tree. If parts of it don't apply, you'll be shown which ones and can choose to apply it with a three-way merge instead.",
+		ApplyPatchFromClipboard:             "Apply patch from clipboard",
+		ApplyPatchFromClipboardTooltip:      "Apply the patch in the clipboard to the working tree. If parts of it don't apply, you'll be shown which ones and can choose to apply it with a three-way merge instead.",
+		ApplyPatchFilePrompt:                "Patch file to apply:",
+		NoPatchInClipboard:                  "The clipboard doesn't contain a patch",
+		ApplyPatchTo:                        "Apply patch to",
+		ApplyPatchToWorkingTree:             "Working tree",
+		ApplyPatchToIndex:                   "Working tree and index",
+		ApplyPatchToIndexTooltip:            "Apply the patch and stage the changes it makes (git apply --index).",
+		PatchDoesNotApplyTitle:              "Patch doesn't apply cleanly",
+		PatchDoesNotApplyPrompt:             "The following parts of the patch don't apply to the current version of the files:\n\n{{.failures}}\n\nApply it with a three-way merge instead? This only works if the patch records which versions of the files it was made from. Hunks that can't be merged cleanly will show up as conflicts.",
+		PatchAppliedWithConflicts:           "Patch applied with conflicts. Resolve them in the files panel.",
+		SplitHunk:                           "Split hunk",
+		SplitHunkTooltip:                    "Split the selected hunk into smaller hunks at the unchanged lines between its changes, so that they can be staged one at a time. This is like `s` in `git add -p`.",
+		CannotSplitHunk:                     "This hunk can't be split any further",
+		SkipHunk:                            "Skip hunk",
+		SkipHunkTooltip:                     "Leave the selected hunk unstaged and move on to the next one, continuing with the next file after the last hunk.",
+		OnlyWhileStagingHunksOneByOne:       "Only available while staging hunks one by one",
+		StageHunksOneByOne:                  "Stage hunks one by one",
+		StageHunksOneByOneTooltip:           "Step through the hunks of every file with unstaged changes in turn, like `git add -p`. Stage a hunk with space, skip it, or split it into smaller hunks; once a file has no more hunks we move on to the next one. Untracked and conflicted files are left out.",
+		NoUnstagedHunks:                     "There are no unstaged changes in tracked files",
+		NoMoreHunksToStage:                  "No more hunks to stage",
+		AddTrailer:                          "Add trailer",
+		ConventionalCommitTypeTitle:         "Commit type",
+		ConventionalCommitScopeTitle:        "Scope (leave empty for none)",
+		ConventionalCommitSummaryError:      "The summary doesn't follow the conventional commit format 'type(scope): description', e.g. 'feat(parser): support arrays'. The scope is optional.",
+		CommitLintSummaryLength:             "Summary is longer than {{max}} characters",
+		CommitLintBodyLineLength:            "{{count}} description line(s) longer than {{max}} characters",
+		CommitLintSummaryTrailingPeriod:     "Summary ends with a period",
+		CommitLintTicketReference:           "No ticket reference matching '{{pattern}}'",
+		CommitLintBlankLineAfterSummary:     "Summary isn't followed by a blank line",
+		CommitLintTicketPatternError:        "Error in ticketReference pattern",
+		CommitLintBlockedError:              "The commit message breaks the following rules:",
+		Absorb:                              "Absorb staged changes into fixup commits",
+		AbsorbTooltip:                       "Blame each staged hunk to find the commit on the current branch that it belongs to, and create a fixup! commit for each of those commits. Hunks that can't be assigned to a single commit stay staged. You can squash the fixup! commits into their commits straight away.",
+		AbsorbTitle:                         "Absorb staged changes",
+		AbsorbCreateFixupCommits:            "Create fixup! commits",
+		AbsorbCreateFixupCommitsAndSquash:   "Create fixup! commits and squash them",
+		AbsorbPlan:                          "Hunks to absorb:",
+		AbsorbNoStagedChanges:               "There are no staged changes to absorb",
+		AbsorbWhileRebasingError:            "You can't absorb changes while in a merging or rebasing state",
+		AbsorbNothingAssigned:               "None of the staged hunks could be assigned to a commit on the current branch:",
+		AbsorbUnassignedHunks:               "The following hunks couldn't be assigned to a commit and were left staged:",
+		AbsorbHunkSeveralCommits:            "touches lines from more than one commit",
+		AbsorbHunkNotOnBranch:               "belongs to a commit that isn't on the current branch",
+		AbsorbHunkUnknownCommit:             "couldn't tell which commit it belongs to",
+		AbsorbHunkUnsupportedFile:           "new, deleted, renamed or binary file, or changed file mode",
+		AbsorbingStatus:                     "Absorbing",
+		FindingAbsorbTargetsStatus:          "Finding commits to absorb into",
+		SplitCommit:                         "Split commit",
+		SplitCommitTooltip:                  "Split the selected commit into several commits. This stops at the commit in an interactive rebase and shows its files, where you add the changes for the first new commit to the custom patch and commit them with `{{commitKey}}`. Repeat until everything has been committed, and the rebase continues. To keep all remaining changes in one last commit instead, reset the split mode, e.g. by pressing `{{resetKey}}` in the commits view.",
+		CantSplitMergeCommit:                "Can't split a merge commit, or a commit with merge commits above it.",
+		CantSplitFirstCommit:                "Can't split the first commit of the repository.",
+		CommitSplitPart:                     "Commit split part",
+		CommitSplitPartTooltip:              "Commit the changes in the custom patch as the next part of the commit being split. Whatever is left over stays in the commit, ready for the next part.",
+		NotSplittingCommit:                  "Only available while splitting a commit.",
+		SplitPartNotInPatch:                 "Add the changes for the new commit to the custom patch first.",
+		SplitCommitPartTitle:                "Commit split part",
+		SplittingCommitStatus:               "Splitting commit",
+		SplittingCommitMode:                 "Splitting commit {{sha}}",
+		MoveCommitsToBranch:                 "Move to branch",
+		MoveCommitsToBranchTooltip:          "Move the selected commits to another local branch without checking it out. They are either put on a new branch, or picked onto an existing one, and then dropped from the current branch.",
+		MoveCommitsToNewBranch:              "New branch",
+		MoveCommitsToNewBranchTooltip:       "Create a new branch with the selected commits on top, forking off where they are now. This means that the new branch also contains all the commits below the selected ones.",
+		MoveCommitsToExistingBranch:         "Existing branch",
+		MoveCommitsToExistingBranchTooltip:  "Pick the selected commits onto the tip of an existing local branch. This is done in a rebase of the current branch, so if there are conflicts you can resolve them and continue as usual; if you abort the rebase instead, both branches stay as they were.",
+		MoveCommitsToBranchTitle:            "Move commits to branch",
+		MoveCommitsNewBranchPrompt:          "New branch name",
+		MoveCommitsExistingBranchPrompt:     "Move commits onto branch",
+		MoveCommitsBranchDoesNotExist:       "Branch '{{branch}}' does not exist.",
+		MoveCommitsToCurrentBranch:          "The commits are already on '{{branch}}'.",
+		MoveCommitsBranchCheckedOut:         "Branch '{{branch}}' is checked out in another worktree. Cherry-pick the commits there instead.",
+		CantMoveFirstCommitToExistingBranch: "Can't move the first commit of the repository onto another branch.",
+		CantMoveMergeCommitsToBranch:        "Can't move merge commits to another branch.",
+		MovingCommitsStatus:                 "Moving commits",
+		RebaseStackRequiresNewerGit:         "Rebasing a branch stack requires git 2.38 or later.",
+		MoveToBranchRequiresNewerGit:        "Moving commits to an existing branch requires git 2.38 or later.",
+		BranchStack:                         "Branch stack",
+		BranchStackTooltip:                  "View options for the stack of branches that the checked-out branch builds on, i.e. the local branches whose heads are among its commits that aren't on a main branch yet: rebase them all onto a main branch at once, or push them all.",
+		BranchStackDescription:              "Branches in the stack, from the bottom to the top:\n{{branches}}",
+		NoBranchStack:                       "The checked-out branch is not part of a branch stack.",
+		RebaseStackOnto:                     "Rebase stack onto '{{ref}}'",
+		PushBranchStack:                     "Push all branches of the stack (force with lease)",
+		PushingBranchStackStatus:            "Pushing branch stack",
+		PushBranchStackResultsTitle:         "Push branch stack",
+		PushBranchStackSucceeded:            "✓ {{branch}} → {{upstream}}",
+		PushBranchStackFailed:               "✗ {{branch}} → {{upstream}}:\n    {{error}}",
+		PushBranchStackSkipped:              "- {{branch}}: skipped, because it has no upstream and there's no obvious remote to push it to",
+		ConflictPreviewRequiresNewerGit:     "Previewing conflicts requires git 2.38 or later.",
+		PreviewConflicts:                    "Preview conflicts",
+		PreviewConflictsTooltip:             "Check whether merging this branch into the checked-out branch, or rebasing the checked-out branch onto it, would run into conflicts, without touching the working tree. The files that would change are shown in the main view, and the files that would conflict next to it.",
+		PreviewMerge:                        "Preview merging '{{selectedBranch}}' into '{{checkedOutBranch}}'",
+		PreviewRebase:                       "Preview rebasing '{{checkedOutBranch}}' onto '{{selectedBranch}}'",
+		CantPreviewConflictsWithSelf:        "You cannot preview merging or rebasing a branch with itself",
+		MergePreviewTitle:                   "Merge preview",
+		RebasePreviewTitle:                  "Rebase preview",
+		ConflictPreviewSummaryTitle:         "Conflicts",
+		CheckingForConflictsStatus:          "Checking for conflicts",
+		MergePreviewNoConflicts:             "Merging '{{ref}}' into '{{checkedOutBranch}}' would not cause any conflicts.",
+		MergePreviewConflicts:               "Merging '{{ref}}' into '{{checkedOutBranch}}' would cause conflicts in:",
+		RebasePreviewNoConflicts:            "Rebasing '{{checkedOutBranch}}' onto '{{ref}}' would not cause any conflicts.",
+		RebasePreviewConflicts:              "Rebasing '{{checkedOutBranch}}' onto '{{ref}}' would stop at commit {{commit}} '{{subject}}' with conflicts in:",
+		UserIdentityNotConfigured:           "Set user.name and user.email in your git config to sign off commits.",
+		LineHistoryPartlyStaged:             "Cannot view the history of unstaged lines while the file also has staged changes",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1605,6 +2271,39 @@ func EnglishTranslationSet() TranslationSet {
 			BisectMark:                        "Bisect mark",
 			RemoveWorktree:                    "Remove worktree",
 			AddWorktree:                       "Add worktree",
+			EditNote:                          "Edit note",
+			RemoveNote:                        "Remove note",
+			PushNotes:                         "Push notes",
+			FetchNotes:                        "Fetch notes",
+			LfsLockFile:                       "Lock file (LFS)",
+			LfsUnlockFile:                     "Unlock file (LFS)",
+			ExportPatches:                     "Export patches",
+			CopyPatchesToClipboard:            "Copy patches to clipboard",
+			ApplyPatches:                      "Apply patches",
+			StashSelectedFiles:                "Stash selected files",
+			StashSelection:                    "Stash selected lines",
+			BranchFromStash:                   "Create branch from stash",
+			AddSparseCheckoutDirectory:        "Add directory to sparse checkout",
+			RemoveSparseCheckoutDirectory:     "Remove directory from sparse checkout",
+			ReapplySparseCheckout:             "Reapply sparse checkout",
+			DisableSparseCheckout:             "Disable sparse checkout",
+			ForgetRerereResolution:            "Forget rerere resolution",
+			DeleteRerereResolution:            "Delete rerere resolution",
+			ResolveConflictInMergeEditor:      "Resolve conflict in merge editor",
+			ShowConflictBase:                  "Show base version of conflicts",
+			CheckoutConflictVersion:           "Checkout version of conflicted file",
+			RemoveConflictedFile:              "Remove conflicted file",
+			BisectRun:                         "Run bisect",
+			BisectSaveLog:                     "Save bisect log",
+			BisectReplayLog:                   "Replay bisect log",
+			SavePatchToFile:                   "Save patch to file",
+			ApplyPatchFile:                    "Apply patch file",
+			Absorb:                            "Absorb staged changes",
+			SplitCommit:                       "Split commit",
+			CommitSplitPart:                   "Commit split part",
+			MoveCommitsToBranch:               "Move commits to branch",
+			RebaseBranchStack:                 "Rebase branch stack",
+			PushBranchStack:                   "Push branch stack",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -1622,6 +2321,19 @@ func EnglishTranslationSet() TranslationSet {
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			Bisecting:                   "Bisecting",
+			Run:                         "Run command to bisect automatically",
+			RunTooltip:                  "Check out one commit after another and run a shell command on each to find out whether it is good or bad (git bisect run), until the first bad commit has been found. An exit code of 0 means good, 125 means the commit can't be tested and should be skipped, and any other code up to 127 means bad. An exit code of 128 or more aborts the bisect run.",
+			RunPrompt:                   "Command to test each commit with:",
+			RunNeedsBothTerms:           "Mark at least one %s and one %s commit first.",
+			Running:                     "Running bisect",
+			RunStopped:                  "The bisect run stopped before it found the first %s commit. Check the command log for the output of each step.",
+			SaveLog:                     "Save bisect log",
+			SaveLogTooltip:              "Save the log of this bisect session (git bisect log) to a file, so that you or someone else can pick up where you left off by replaying it.",
+			SaveLogPrompt:               "Save bisect log to:",
+			SavedLog:                    "Saved bisect log to '{{.path}}'",
+			ReplayLog:                   "Replay bisect log",
+			ReplayLogTooltip:            "Start a bisect session from a log saved earlier (git bisect replay), marking the same commits as in that session.",
+			ReplayLogPrompt:             "Bisect log to replay:",
 		},
 		Log: Log{
 			EditRebase:               "Beginning interactive rebase at '{{.ref}}'",
@@ -37,6 +37,13 @@ func (self *CommitDescriptionPanelDriver) Title(expected *TextMatcher) *CommitDe
 	return self
 }
 
+// asserts on the lint violations shown below the description
+func (self *CommitDescriptionPanelDriver) Footer(expected *TextMatcher) *CommitDescriptionPanelDriver {
+	self.getViewDriver().Footer(expected)
+
+	return self
+}
+
 func (self *CommitDescriptionPanelDriver) Cancel() {
 	self.getViewDriver().PressEscape()
 }
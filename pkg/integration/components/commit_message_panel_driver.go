@@ -27,6 +27,13 @@ func (self *CommitMessagePanelDriver) Title(expected *TextMatcher) *CommitMessag
 	return self
 }
 
+// asserts on the lint violations shown below the summary
+func (self *CommitMessagePanelDriver) Footer(expected *TextMatcher) *CommitMessagePanelDriver {
+	self.getViewDriver().Footer(expected)
+
+	return self
+}
+
 func (self *CommitMessagePanelDriver) Type(value string) *CommitMessagePanelDriver {
 	self.t.typeContent(value)
 
@@ -72,6 +79,10 @@ func (self *CommitMessagePanelDriver) SwitchToEditor() {
 	self.getViewDriver().Press(self.t.keys.CommitMessage.SwitchToEditor)
 }
 
+func (self *CommitMessagePanelDriver) OpenTrailersMenu() {
+	self.getViewDriver().Press(self.t.keys.CommitMessage.AddTrailer)
+}
+
 func (self *CommitMessagePanelDriver) SelectPreviousMessage() *CommitMessagePanelDriver {
 	self.getViewDriver().SelectPreviousItem()
 	return self
@@ -40,6 +40,15 @@ func (self *ViewDriver) Title(expected *TextMatcher) *ViewDriver {
 	return self
 }
 
+func (self *ViewDriver) Footer(expected *TextMatcher) *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		actual := self.getView().Footer
+		return expected.context(fmt.Sprintf("%s footer", self.context)).test(actual)
+	})
+
+	return self
+}
+
 // asserts that the view has lines matching the given matchers. One matcher must be passed for each line.
 // If you only care about the top n lines, use the TopLines method instead.
 // If you only care about a subset of lines, use the ContainsLines method instead.
@@ -334,7 +343,7 @@ func (self *ViewDriver) Focus() *ViewDriver {
 	}
 	windows := []window{
 		{name: "status", viewNames: []string{"status"}},
-		{name: "files", viewNames: []string{"files", "worktrees", "submodules"}},
+		{name: "files", viewNames: []string{"files", "worktrees", "submodules", "sparseCheckout"}},
 		{name: "branches", viewNames: []string{"localBranches", "remotes", "tags"}},
 		{name: "commits", viewNames: []string{"commits", "reflogCommits"}},
 		{name: "stash", viewNames: []string{"stash"}},
@@ -407,6 +416,15 @@ func (self *ViewDriver) PressFast(keyStr string) *ViewDriver {
 	return self
 }
 
+// for typing into an editable view
+func (self *ViewDriver) Type(content string) *ViewDriver {
+	self.IsFocused()
+
+	self.t.typeContent(content)
+
+	return self
+}
+
 func (self *ViewDriver) Click(x, y int) *ViewDriver {
 	offsetX, offsetY, _, _ := self.getView().Dimensions()
 
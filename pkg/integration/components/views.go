@@ -42,6 +42,26 @@ func (self *Views) MergeConflicts() *ViewDriver {
 	return self.regularView("mergeConflicts")
 }
 
+func (self *Views) MergeEditorOurs() *ViewDriver {
+	return self.regularView("mergeEditorOurs")
+}
+
+func (self *Views) MergeEditorBase() *ViewDriver {
+	return self.regularView("mergeEditorBase")
+}
+
+func (self *Views) MergeEditorTheirs() *ViewDriver {
+	return self.regularView("mergeEditorTheirs")
+}
+
+func (self *Views) MergeEditorResult() *ViewDriver {
+	return self.regularView("mergeEditorResult")
+}
+
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Commits() *ViewDriver {
 	return self.regularView("commits")
 }
@@ -62,6 +82,10 @@ func (self *Views) Submodules() *ViewDriver {
 	return self.regularView("submodules")
 }
 
+func (self *Views) SparseCheckout() *ViewDriver {
+	return self.regularView("sparseCheckout")
+}
+
 func (self *Views) Information() *ViewDriver {
 	return self.regularView("information")
 }
@@ -82,6 +106,14 @@ func (self *Views) RemoteBranches() *ViewDriver {
 	return self.regularView("remoteBranches")
 }
 
+func (self *Views) RangeDiff() *ViewDriver {
+	return self.regularView("rangeDiff")
+}
+
+func (self *Views) RerereResolutions() *ViewDriver {
+	return self.regularView("rerereResolutions")
+}
+
 func (self *Views) Tags() *ViewDriver {
 	return self.regularView("tags")
 }
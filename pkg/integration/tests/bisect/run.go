@@ -0,0 +1,58 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Run = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Let git bisect find the bad commit by running a command on each commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				// we can't run a bisect until we have both a good and a bad commit
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run command to bisect automatically")).
+					Confirm()
+
+				t.ExpectToast(Contains("Disabled: Mark at least one bad and one good commit first."))
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Cancel()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run command to bisect automatically")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to test each commit with:")).
+					Type("test ! -f file06.txt").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Bisect complete")).
+					Content(MatchesRegexp("(?s)commit 06.*Do you want to reset")).
+					Cancel()
+			}).
+			SelectedLine(Contains("CI commit 06"))
+	},
+})
@@ -48,6 +48,8 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("b Mark current commit").Contains("as bad"),
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
+						Contains("x Run command to bisect automatically"),
+						Contains("l Save bisect log"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
@@ -76,6 +78,8 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
 						Contains("S Skip selected commit"),
+						Contains("x Run command to bisect automatically"),
+						Contains("l Save bisect log"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
@@ -0,0 +1,95 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var PreviewConflicts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Preview the conflicts of merging and rebasing without touching the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+		shell.
+			Checkout("original-branch").
+			NewBranch("unrelated-branch").
+			CreateFileAndAdd("other-file", "other content").
+			Commit("unrelated change").
+			Checkout("first-change-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			SelectedLine(Contains("first-change-branch")).
+			Press(keys.Branches.PreviewConflicts).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: You cannot preview merging or rebasing a branch with itself"))
+			}).
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.PreviewConflicts)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Preview conflicts")).
+			Select(Contains("Preview merging 'second-change-branch' into 'first-change-branch'")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Merge preview")).
+			Content(
+				Contains("<<<<<<< HEAD").
+					Contains("First Change").
+					Contains(">>>>>>> second-change-branch"),
+			)
+
+		t.Views().Secondary().
+			Title(Equals("Conflicts")).
+			Content(
+				Contains("Merging 'second-change-branch' into 'first-change-branch' would cause conflicts in:").
+					Contains("  file"),
+			)
+
+		t.Views().Branches().
+			Press(keys.Branches.PreviewConflicts)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Preview conflicts")).
+			Select(Contains("Preview rebasing 'first-change-branch' onto 'second-change-branch'")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Rebase preview"))
+
+		t.Views().Secondary().
+			Content(
+				Contains("Rebasing 'first-change-branch' onto 'second-change-branch' would stop at commit").
+					Contains("'first change' with conflicts in:").
+					Contains("  file"),
+			)
+
+		t.Views().Branches().
+			NavigateToLine(Contains("unrelated-branch")).
+			Press(keys.Branches.PreviewConflicts)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Preview conflicts")).
+			Select(Contains("Preview merging")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Merge preview")).
+			Content(Contains("+other content"))
+
+		t.Views().Secondary().
+			Content(Equals("Merging 'unrelated-branch' into 'first-change-branch' would not cause any conflicts."))
+
+		// Nothing has been touched
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Information().Content(DoesNotContain("Merging").DoesNotContain("Rebasing"))
+	},
+})
@@ -0,0 +1,83 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare a rebased branch with its upstream and with an earlier version from its reflog",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("base")
+		shell.CreateFileAndAdd("a", "1\n2\n3\n4\n5\n6\n7\n8\n")
+		shell.Commit("add a")
+		shell.CreateFileAndAdd("b", "1\n2\n3\n4\n5\n6\n7\n8\n")
+		shell.Commit("add b")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^")
+		shell.CreateFileAndAdd("b", "1\n2\n3\n4\n5\n6\n7\n8\n9\n")
+		shell.Commit("add b")
+		shell.CreateFileAndAdd("c", "c")
+		shell.Commit("add c")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(Contains("master").IsSelected()).
+			Press(keys.Branches.ViewRangeDiffOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Range-diff for 'master'")).
+			Select(Contains("Compare with origin/master")).
+			Confirm()
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Equals("Range diff (origin/master...master)")).
+			Lines(
+				Contains("1: ").Contains("!").Contains("add b").IsSelected(),
+				Contains("-: -------").Contains(">").Contains("add c"),
+			).
+			SelectNextItem()
+
+		t.Views().Main().
+			Content(Contains("add c"))
+
+		t.Views().RangeDiff().
+			SelectPreviousItem()
+
+		t.Views().Main().
+			Content(Contains("@@ b (new)").Contains("+9"))
+
+		t.Views().RangeDiff().
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			Press(keys.Branches.ViewRangeDiffOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Range-diff for 'master'")).
+			Select(Contains("Compare with earlier version from branch reflog")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Compare with earlier version from branch reflog")).
+			Select(Contains("reset: moving to HEAD^")).
+			Confirm()
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Contains("master@{")).
+			Lines(
+				Contains(">").Contains("add b").IsSelected(),
+				Contains(">").Contains("add c"),
+			)
+	},
+})
@@ -0,0 +1,68 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseStack = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rebase a stack of branches onto an updated main branch with a single command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowBranchStackNames = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("part-1").
+			EmptyCommit("part 1a").
+			EmptyCommit("part 1b").
+			NewBranch("part-2").
+			EmptyCommit("part 2").
+			Checkout("master").
+			EmptyCommit("master update").
+			Checkout("part-2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("part 2").IsSelected(),
+				Contains("* part-1 part 1b"),
+				Contains("part 1a"),
+				Contains("base"),
+			).
+			Press(keys.Commits.OpenBranchStackMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branch stack")).
+			Select(Contains("Rebase stack onto 'master'")).
+			Tooltip(Contains("part-1").Contains("part-2")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("part 2"),
+				Contains("* part-1 part 1b"),
+				Contains("part 1a"),
+				Contains("master update"),
+				Contains("base"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("part-1")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("part 1b").IsSelected(),
+				Contains("part 1a"),
+				Contains("master update"),
+				Contains("base"),
+			)
+	},
+})
@@ -0,0 +1,57 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RebaseWithConflictWarning = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Get warned that a rebase and a merge will conflict before starting them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.RebaseBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'first-change-branch' onto 'second-change-branch'")).
+			Select(Contains("Simple rebase")).
+			Tooltip(
+				Contains("Rebasing 'first-change-branch' onto 'second-change-branch' would stop at commit").
+					Contains("'first change' with conflicts in:").
+					Contains("  file"),
+			).
+			Select(Contains("Interactive rebase")).
+			Tooltip(
+				Contains("would stop at commit").
+					Contains("Begin an interactive rebase"),
+			).
+			Cancel()
+
+		t.Views().Information().Content(DoesNotContain("Rebasing"))
+
+		t.Views().Branches().
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Merge")).
+			Content(
+				Contains("Merging 'second-change-branch' into 'first-change-branch' would cause conflicts in:").
+					Contains("  file").
+					Contains("Are you sure you want to merge 'second-change-branch' into 'first-change-branch'?"),
+			).
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Information().Content(Contains("Merging"))
+	},
+})
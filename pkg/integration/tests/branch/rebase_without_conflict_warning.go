@@ -0,0 +1,47 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RebaseWithoutConflictWarning = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Turn off checking a rebase and a merge for conflicts before starting them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Merging.WarnAboutConflicts = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.RebaseBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'first-change-branch' onto 'second-change-branch'")).
+			Select(Contains("Simple rebase")).
+			Tooltip(DoesNotContain("would stop at commit")).
+			Cancel()
+
+		t.Views().Branches().
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Merge")).
+			Content(
+				DoesNotContain("would cause conflicts").
+					Contains("Are you sure you want to merge 'second-change-branch' into 'first-change-branch'?"),
+			).
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Information().Content(Contains("Merging"))
+	},
+})
@@ -0,0 +1,101 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Absorb = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb staged hunks into fixup commits for the commits they belong to, leaving the rest staged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("base", "base\n").
+			Commit("on master").
+			NewBranch("mybranch").
+			CreateFileAndAdd("file", "a1\na2\na3\na4\na5\na6\na7\na8\n").
+			Commit("add file").
+			UpdateFileAndAdd("file", "a1\na2\na3\na4\na5\na6\nb7\na8\n").
+			Commit("change line 7").
+			CreateFileAndAdd("other", "x\n").
+			Commit("add other").
+			UpdateFileAndAdd("file", "a1\nA2\na3\na4\na5\na6\nB7\na8\n").
+			UpdateFileAndAdd("other", "X\n").
+			UpdateFileAndAdd("base", "BASE\n").
+			CreateFileAndAdd("newfile", "new\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.Absorb)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			Select(Contains("Create fixup! commits").DoesNotContain("squash")).
+			Tooltip(
+				Contains("file:2 → ").Contains("add file").
+					Contains("file:7 → ").Contains("change line 7").
+					Contains("other:1 → ").Contains("add other").
+					Contains("base:1 (belongs to a commit that isn't on the current branch)"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Absorb staged changes")).
+			Content(Equals("The following hunks couldn't be assigned to a commit and were left staged:\n\nbase:1 (belongs to a commit that isn't on the current branch)\nnewfile:1 (new, deleted, renamed or binary file, or changed file mode)")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("fixup! add other"),
+				Contains("fixup! change line 7"),
+				Contains("fixup! add file"),
+				Contains("add other"),
+				Contains("change line 7"),
+				Contains("add file"),
+				Contains("on master"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("M  base"),
+				Contains("A  newfile"),
+			)
+
+		// Absorb another change, squashing it right away
+		t.Shell().UpdateFileAndAdd("other", "Y\n")
+
+		t.Views().Files().
+			Press(keys.Files.RefreshFiles).
+			Press(keys.Files.Absorb)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			Select(Contains("Create fixup! commits and squash them")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Absorb staged changes")).
+			Content(Contains("base:1").Contains("newfile:1")).
+			Confirm()
+
+		// The line was last changed by the fixup! commit, so it's absorbed
+		// into the commit that that one belongs to
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fixup! change line 7"),
+				Contains("fixup! add file"),
+				Contains("add other"),
+				Contains("change line 7"),
+				Contains("add file"),
+				Contains("on master"),
+			).
+			NavigateToLine(Contains("add other").DoesNotContain("fixup!"))
+
+		t.Views().Main().
+			Content(Contains("+Y"))
+	},
+})
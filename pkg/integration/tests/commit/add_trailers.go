@@ -0,0 +1,90 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddTrailers = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add trailers to a commit message from the commit message panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.Trailers = []string{"Fixes"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("John Smith", "jsmith@example.com")
+		shell.EmptyCommit("one")
+		shell.SetAuthor("CI", "CI@example.com")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("my commit").
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Lines(
+				Contains("Signed-off-by").IsSelected(),
+				Contains("Co-authored-by"),
+				Contains("Reviewed-by"),
+				Contains("Fixes"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().CommitDescription().
+			Content(Equals("Signed-off-by: CI <CI@example.com>"))
+
+		t.ExpectPopup().CommitMessagePanel().
+			Content(Equals("my commit")).
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("Co-authored-by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Co-authored-by")).
+			Type("John").
+			SuggestionLines(
+				Contains("John Smith <jsmith@example.com>"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().CommitMessagePanel().
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("Fixes")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Fixes")).
+			Type("#123").
+			Confirm()
+
+		t.Views().CommitDescription().
+			Content(Equals("Signed-off-by: CI <CI@example.com>\nCo-authored-by: John Smith <jsmith@example.com>\nFixes: #123"))
+
+		t.ExpectPopup().CommitMessagePanel().
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("my commit").IsSelected(),
+				Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("my commit\n    \n    Signed-off-by: CI <CI@example.com>\n    Co-authored-by: John Smith <jsmith@example.com>\n    Fixes: #123"))
+	},
+})
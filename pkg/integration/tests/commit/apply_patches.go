@@ -0,0 +1,76 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply an mbox with git am, skipping a patch that conflicts",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "base\n")
+		shell.Commit("base")
+		shell.UpdateFileAndAdd("file", "two\n")
+		shell.Commit("two")
+		shell.CreateFileAndAdd("other-file", "three\n")
+		shell.Commit("three")
+		shell.RunShellCommand("git format-patch --stdout HEAD~2..HEAD > ../series.mbox")
+
+		shell.HardReset("HEAD~2")
+		shell.UpdateFileAndAdd("file", "conflicting\n")
+		shell.Commit("conflicting")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("conflicting").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.OpenPatchFilesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Apply patch file or mbox")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path of patch file or mbox to apply:")).
+			Type("../series.mbox").
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file"),
+			)
+
+		t.Views().Status().
+			Content(Contains("(applying patches)"))
+
+		t.GlobalPress(keys.Universal.CreateRebaseOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Apply patches options")).
+			Select(Contains("skip")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("conflicting"),
+				Contains("base"),
+			)
+
+		t.Views().Status().
+			Content(DoesNotContain("applying patches"))
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
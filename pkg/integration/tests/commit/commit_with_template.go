@@ -0,0 +1,42 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitWithTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with the commit message panel preloaded from git's commit.template",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile(".git/commit-template", "Summary: \n\n# Explain why this change is needed\nTicket: \n")
+		shell.SetConfig("commit.template", ".git/commit-template")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("Summary: ")).
+			Type("my commit")
+
+		t.Views().CommitDescription().
+			Content(Equals("Ticket:"))
+
+		t.ExpectPopup().CommitMessagePanel().
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Summary: my commit").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Summary: my commit\n    \n    Ticket:"))
+	},
+})
@@ -0,0 +1,66 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ConventionalCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with conventional commits enabled, picking the type and scope up front",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.ConventionalCommits = true
+		config.UserConfig.Git.Commit.ConventionalCommitTypes = []string{"feat", "fix"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("fix(parser): handle empty input")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit type")).
+			Lines(
+				Contains("feat").IsSelected(),
+				Contains("fix"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Scope (leave empty for none)")).
+			Type("par").
+			SuggestionLines(
+				Contains("parser"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("feat(parser): ")).
+			Clear().
+			Type("add a parser").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("type(scope): description")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Content(Equals("add a parser")).
+			Clear().
+			Type("feat: add a parser").
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("feat: add a parser").IsSelected(),
+				Contains("fix(parser): handle empty input"),
+			)
+	},
+})
@@ -0,0 +1,64 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the clipboard by writing to a file called clipboard
+
+var ExportPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export a range of commits as patch files to a directory and as an mbox to the clipboard",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.CopyToClipboardCmd = "printf '%s' {{text}} > ../clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "two\n")
+		shell.Commit("two")
+		shell.UpdateFileAndAdd("file", "three\n")
+		shell.Commit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.OpenPatchFilesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Save selected commits as patch files in directory")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to save patch files to:")).
+			Type("../patches").
+			Confirm()
+
+		t.ExpectToast(Equals("Saved 2 patch file(s) to ../patches"))
+
+		t.FileSystem().FileContent("../patches/0001-two.patch", Contains("Subject: [PATCH 1/2] two"))
+		t.FileSystem().FileContent("../patches/0002-three.patch", Contains("Subject: [PATCH 2/2] three"))
+
+		t.Views().Commits().
+			Press(keys.Commits.OpenPatchFilesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Copy selected commits as mbox to clipboard")).
+			Confirm()
+
+		t.ExpectToast(Equals("Patches copied to clipboard"))
+
+		t.FileSystem().FileContent("../clipboard",
+			Contains("Subject: [PATCH 1/2] two").Contains("Subject: [PATCH 2/2] three"))
+	},
+})
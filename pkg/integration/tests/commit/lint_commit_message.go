@@ -0,0 +1,77 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LintCommitMessage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show commit message lint violations while typing, and refuse to commit or reword while a blocking rule is broken",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Git.Commit.Lint.SummaryLength = config.CommitLintLengthRule{Level: "block", Max: 20}
+		cfg.UserConfig.Git.Commit.Lint.SummaryTrailingPeriod = config.CommitLintRule{Level: "warn"}
+		cfg.UserConfig.Git.Commit.Lint.BodyLineLength = config.CommitLintLengthRule{Level: "warn", Max: 10}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("A commit with a summary that is too long")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("Add a file.").
+			Footer(Equals("⚠ Summary ends with a period")).
+			SwitchToDescription().
+			Type("A long description line").
+			Footer(Equals("⚠ 1 description line(s) longer than 10 characters")).
+			SwitchToSummary().
+			Type(" with a long summary").
+			Footer(Equals("✗ Summary is longer than 20 characters")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("The commit message breaks the following rules:\n\n- Summary is longer than 20 characters")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Clear().
+			Type("Add a file").
+			Footer(Equals("")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Add a file").IsSelected(),
+				Contains("A commit with a summary that is too long"),
+			).
+			NavigateToLine(Contains("A commit with a summary that is too long")).
+			Press(keys.Commits.RenameCommit)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Footer(Equals("✗ Summary is longer than 20 characters")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Summary is longer than 20 characters")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Clear().
+			Type("A short summary").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("Add a file"),
+				Contains("A short summary").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,101 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveCommitsToBranchWithConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a commit onto a branch where it conflicts, resolving the conflict before it's dropped from the current branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "base\n")
+		shell.Commit("base commit")
+
+		shell.NewBranch("other")
+		shell.UpdateFileAndAdd("file", "other\n")
+		shell.Commit("other commit")
+
+		shell.Checkout("master")
+		shell.UpdateFileAndAdd("file", "master\n")
+		shell.Commit("master commit")
+		shell.CreateFileAndAdd("unrelated", "unrelated\n")
+		shell.Commit("unrelated commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("unrelated commit").IsSelected(),
+				Contains("master commit"),
+				Contains("base commit"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Move commits to branch")).
+			Select(Contains("Existing branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move commits onto branch")).
+			Type("other").
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Commits().
+			ContainsLines(
+				Contains("<-- YOU ARE HERE --- master commit"),
+				Contains("other commit"),
+				Contains("base commit"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file"),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			TopLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("other"),
+				Contains("======="),
+				Contains("master"),
+				Contains(">>>>>>>"),
+			).
+			SelectNextItem().
+			PressPrimaryAction() // pick "master"
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.Views().Commits().
+			Lines(
+				Contains("unrelated commit"),
+				Contains("base commit"),
+			)
+
+		t.Git().CurrentBranchName("master")
+		t.Views().Files().IsEmpty()
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("other")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("master commit").IsSelected(),
+				Contains("other commit"),
+				Contains("base commit"),
+			)
+	},
+})
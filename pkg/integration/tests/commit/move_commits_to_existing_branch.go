@@ -0,0 +1,96 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveCommitsToExistingBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a range of commits onto another existing branch without checking it out",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("base", "base")
+		shell.Commit("base commit")
+
+		shell.NewBranch("other")
+		shell.CreateFileAndAdd("other", "other")
+		shell.Commit("other commit")
+
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("one", "one")
+		shell.Commit("one")
+		shell.CreateFileAndAdd("two", "two")
+		shell.Commit("two")
+		shell.CreateFileAndAdd("three", "three")
+		shell.Commit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+				Contains("base commit"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Move commits to branch")).
+			Select(Contains("Existing branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move commits onto branch")).
+			SuggestionLines(Contains("other")).
+			Type("master").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("The commits are already on 'master'.")).
+			Confirm()
+
+		t.Views().Commits().
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Move commits to branch")).
+			Select(Contains("Existing branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move commits onto branch")).
+			Type("oth").
+			SuggestionLines(Contains("other")).
+			ConfirmFirstSuggestion()
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("base commit").IsSelected(),
+			)
+
+		t.Git().CurrentBranchName("master")
+		t.Views().Files().IsEmpty()
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("other")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+				Contains("other commit"),
+				Contains("base commit"),
+			)
+	},
+})
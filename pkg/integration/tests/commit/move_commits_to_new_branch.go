@@ -0,0 +1,57 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveCommitsToNewBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move the top commits of the current branch to a new branch without checking it out",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Move commits to branch")).
+			Select(Contains("New branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("New branch name")).
+			Type("my feature").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 01").IsSelected(),
+			)
+
+		t.Git().CurrentBranchName("master")
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("my-feature")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+	},
+})
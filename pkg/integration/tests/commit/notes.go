@@ -0,0 +1,81 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Notes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add, edit and remove notes on a commit, including notes in a configured notes ref",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.NotesRefs = []string{"refs/notes/review"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.RunCommand([]string{"git", "notes", "--ref=refs/notes/review", "add", "-m", "looks good", "HEAD^"})
+		shell.SetConfig("core.editor", "sh -c 'echo first line > $0'")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").DoesNotContain("✎").IsSelected(),
+				Contains("✎ one"),
+			).
+			Press(keys.Commits.OpenNotesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(MatchesRegexp(`Add/edit note$`)).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("✎ two").IsSelected(),
+				Contains("✎ one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Notes:").Contains("first line"))
+
+		// Editing an existing note keeps all of its lines
+		t.Shell().SetConfig("core.editor", "sh -c 'echo second line >> $0'")
+
+		t.Views().Commits().
+			Press(keys.Commits.OpenNotesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(MatchesRegexp(`Add/edit note$`)).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("first line").Contains("second line"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("one"))
+
+		t.Views().Main().
+			Content(Contains("Notes (review):").Contains("looks good"))
+
+		t.Views().Commits().
+			Press(keys.Commits.OpenNotesMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Remove note (refs/notes/review)")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("✎ two"),
+				Contains("one").DoesNotContain("✎").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(DoesNotContain("looks good"))
+	},
+})
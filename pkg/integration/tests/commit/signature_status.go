@@ -0,0 +1,101 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SignatureStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the signature status of commits, and filter to only show unsigned ones",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Log.ShowSignatureStatus = true
+	},
+	SetupRepo: func(shell *Shell) {
+		// sign with ssh keys, trusting only the first one. Git runs ssh-keygen
+		// from the root of the repo, so the paths are relative to that.
+		shell.RunShellCommand(`ssh-keygen -q -t ed25519 -N "" -C tester -f ../trusted_key`)
+		shell.RunShellCommand(`ssh-keygen -q -t ed25519 -N "" -C other -f ../other_key`)
+		shell.RunShellCommand(`echo "tester $(cat ../trusted_key.pub)" > ../allowed_signers`)
+		shell.SetConfig("gpg.format", "ssh")
+		shell.SetConfig("gpg.ssh.allowedSignersFile", "../allowed_signers")
+
+		shell.EmptyCommit("unsigned")
+		shell.SetConfig("user.signingkey", "../trusted_key.pub")
+		shell.RunCommand([]string{"git", "commit", "-S", "--allow-empty", "-m", "tampered"})
+		// change the message of the signed commit so that the signature no longer matches
+		shell.RunShellCommand(`git reset -q --hard $(git cat-file commit HEAD | sed 's/^tampered$/bad/' | git hash-object -t commit -w --stdin)`)
+		shell.SetConfig("user.signingkey", "../other_key.pub")
+		shell.RunCommand([]string{"git", "commit", "-S", "--allow-empty", "-m", "unknown"})
+		shell.SetConfig("user.signingkey", "../trusted_key.pub")
+		shell.RunCommand([]string{"git", "commit", "-S", "--allow-empty", "-m", "good"})
+		shell.EmptyCommit("also unsigned")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("○ also unsigned").IsSelected(),
+				Contains("✓ good"),
+				Contains("? unknown"),
+				Contains("✗ bad"),
+				Contains("○ unsigned"),
+			)
+
+		t.Views().Main().
+			Content(DoesNotContain("signature"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("good"))
+
+		t.Views().Main().
+			Content(Contains("Good signature").Contains("Signer: tester").Contains("Key: SHA256:"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("unknown"))
+
+		t.Views().Main().
+			Content(Contains("Signature of unknown validity").DoesNotContain("Signer:").Contains("Key: SHA256:"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("bad"))
+
+		t.Views().Main().
+			Content(Contains("Bad signature"))
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Show only unsigned commits")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by unsigned commits"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("also unsigned").IsSelected(),
+				Contains("unsigned"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Show signed commits too")).
+			Confirm()
+
+		t.Views().Information().Content(DoesNotContain("Filtering by"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("also unsigned"),
+				Contains("good"),
+				Contains("unknown"),
+				Contains("bad"),
+				Contains("unsigned"),
+			)
+	},
+})
@@ -0,0 +1,118 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MergeEditor = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve conflicts in the merge editor by picking lines from both sides and editing the result",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFileMultiple(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			).
+			Press(keys.Main.OpenMergeEditor)
+
+		t.Views().MergeEditorBase().
+			Content(Contains("No base version. Press B to show it."))
+
+		t.Views().MergeEditorTheirs().
+			Title(Equals("Theirs (second-change-branch)")).
+			Lines(
+				Contains("Second Change"),
+			)
+
+		t.Views().MergeEditorResult().
+			Title(Equals("Result (conflict 1 of 2)"))
+
+		t.Views().MergeEditorOurs().
+			IsFocused().
+			Title(Equals("Ours (HEAD)")).
+			Lines(
+				Contains("First Change").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("✓ First Change"),
+			).
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().MergeEditorBase().
+			IsFocused().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().MergeEditorTheirs().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().MergeEditorResult().
+			IsFocused().
+			Content(Equals("First Change\nSecond Change\n")).
+			Type("Third Change").
+			Press(keys.Universal.ConfirmInEditor)
+
+		t.Views().MergeEditorResult().
+			Title(Equals("Result (conflict 1 of 1)"))
+
+		t.Views().MergeEditorOurs().
+			Lines(
+				Contains("Other First Change"),
+			)
+
+		t.Views().MergeEditorTheirs().
+			Lines(
+				Contains("Other Second Change"),
+			)
+
+		t.Views().MergeEditorResult().
+			IsFocused().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().MergeEditorOurs().
+			IsFocused().
+			Press(keys.Universal.ConfirmInEditor)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Apply resolution")).
+			Content(Contains("The result is empty")).
+			Confirm()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().FileContent("file", Equals(`
+This
+Is
+The
+First Change
+Second Change
+Third Change
+File
+..
+It
+Is
+Longer
+Than
+The
+Other
+`))
+	},
+})
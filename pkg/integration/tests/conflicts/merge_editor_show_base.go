@@ -0,0 +1,59 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MergeEditorShowBase = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rewrite the conflicts of a file so that the merge editor can show the base version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.35.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Content(DoesNotContain("|||||||")).
+			Press(keys.Main.OpenMergeEditor)
+
+		t.Views().MergeEditorBase().
+			Title(Equals("Base")).
+			Content(Contains("No base version. Press B to show it."))
+
+		t.Views().MergeEditorOurs().
+			IsFocused().
+			Press(keys.Main.ShowConflictBase)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Show base version of conflicts")).
+			Content(Contains("Conflicts you've already resolved in this file will come back.")).
+			Confirm()
+
+		t.Views().MergeEditorBase().
+			Title(Contains("Base (")).
+			Lines(
+				Contains("Original"),
+			)
+
+		t.Views().MergeEditorOurs().
+			IsFocused().
+			Press(keys.Universal.Return)
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Content(Contains("||||||| ")).
+			Content(Contains("Original"))
+	},
+})
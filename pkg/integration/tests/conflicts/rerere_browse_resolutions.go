@@ -0,0 +1,73 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RerereBrowseResolutions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Browse the rerere cache and delete a recorded resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFileResolvedByRerere(shell)
+		shell.RunCommand([]string{"git", "merge", "--abort"})
+
+		// a conflict that was recorded but never resolved
+		shell.CreateFile(".git/rr-cache/0123456789abcdef0123456789abcdef01234567/preimage",
+			"<<<<<<<\nnever resolved\n=======\nother side\n>>>>>>>\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			IsEmpty().
+			Press(keys.Files.OpenRerereMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rerere")).
+					Select(Contains("Browse recorded resolutions")).
+					Confirm()
+			})
+
+		t.Views().RerereResolutions().
+			IsFocused().
+			Lines(
+				Contains("01234567").Contains("unresolved").Contains("never resolved").IsSelected(),
+				Contains("resolved").Contains("First Change"),
+			).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(
+						Contains("-<<<<<<<").
+							Contains("+Resolved"),
+					)
+			}).
+			SelectPreviousItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(
+						Contains("This conflict was recorded, but no resolution has been recorded for it yet.").
+							Contains("never resolved"),
+					)
+			}).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Delete recorded resolution")).
+					Content(Equals("Are you sure you want to delete the recorded resolution '0123456789abcdef0123456789abcdef01234567'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("resolved").Contains("First Change").IsSelected(),
+			).
+			PressEscape()
+
+		t.FileSystem().PathNotPresent(".git/rr-cache/0123456789abcdef0123456789abcdef01234567")
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,41 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RerereForget = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Forget a recorded resolution and resolve the conflict again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo:    shared.CreateMergeConflictFileResolvedByRerere,
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file (resolved by rerere)").IsSelected(),
+			).
+			Press(keys.Files.OpenRerereMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rerere")).
+					Select(Contains("Forget recorded resolution")).
+					Confirm()
+			}).
+			Lines(
+				Contains("UU file").DoesNotContain("rerere").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< ours"),
+				Contains("First Change"),
+				Contains("======="),
+			)
+	},
+})
@@ -0,0 +1,39 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RerereResolved = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage a file whose conflicts rerere resolved from a recorded resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo:    shared.CreateMergeConflictFileResolvedByRerere,
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file (resolved by rerere)").IsSelected(),
+			).
+			PressEnter().
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("'file' was resolved by rerere")).
+					Select(Contains("Stage resolution")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Continue")).
+					Content(Contains("All merge conflicts resolved. Continue?")).
+					Cancel()
+			}).
+			Lines(
+				Contains("M  file").DoesNotContain("rerere"),
+			)
+
+		t.FileSystem().FileContent("file", Equals(shared.RerereResolvedFileContent))
+	},
+})
@@ -0,0 +1,78 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ResolveWholeFileConflicts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve a delete/modify conflict and a binary conflict by picking what to do with the whole file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			NewBranch("original-branch").
+			CreateFileAndAdd("deleted-by-us.txt", "original\n").
+			CreateFileAndAdd("image.bin", "original\x00").
+			Commit("original").
+			NewBranch("first-change-branch").
+			DeleteFileAndAdd("deleted-by-us.txt").
+			UpdateFileAndAdd("image.bin", "ours\x00").
+			Commit("first change").
+			Checkout("original-branch").
+			NewBranch("second-change-branch").
+			UpdateFileAndAdd("deleted-by-us.txt", "theirs\n").
+			UpdateFileAndAdd("image.bin", "theirs\x00").
+			Commit("second change").
+			Checkout("first-change-branch").
+			RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("DU deleted-by-us.txt").IsSelected(),
+				Contains("UU image.bin"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Deleted by us: We deleted this file, but their side changed it.")).
+			Content(Contains("Press <enter> to choose how to resolve the conflict."))
+
+		t.Views().Files().
+			PressEnter()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Deleted by us: deleted-by-us.txt")).
+			Lines(
+				Contains("Keep their version"),
+				Contains("Keep deleted"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Keep deleted")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU image.bin").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Both modified: Both sides changed this binary file"))
+
+		t.Views().Files().
+			PressEnter()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Both modified: image.bin")).
+			Select(Contains("Keep their version")).
+			Confirm()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().PathNotPresent("deleted-by-us.txt")
+		t.FileSystem().FileContent("image.bin", Equals("theirs\x00"))
+	},
+})
@@ -0,0 +1,60 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Blame = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the blame of a file, blame an earlier revision, and jump to the blamed commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file", "one\nTWO\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("other", "content")
+		shell.Commit("third commit")
+		shell.UpdateFile("file", "one\nTWO\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame (file)")).
+			Lines(
+				Contains("one").IsSelected(),
+				Contains("TWO"),
+				Contains("three"),
+			).
+			NavigateToLine(Contains("three")).
+			Press(keys.Blame.BlamePreviousRevision)
+
+		t.ExpectToast(Equals("Disabled: This line has not been committed yet"))
+
+		t.Views().Blame().
+			NavigateToLine(Contains("TWO")).
+			Press(keys.Blame.BlamePreviousRevision).
+			Lines(
+				Contains("one"),
+				Contains("two").IsSelected(),
+			).
+			Press(keys.Universal.GoInto)
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("third commit"),
+				Contains("second commit"),
+				Contains("first commit").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,124 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CombineWithOtherCriteria = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by file path combined with author and content, changing the criteria from the filtering menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Alice", "alice@example.com")
+		shell.CreateFileAndAdd("filterFile", "apple\n")
+		shell.Commit("alice adds apple")
+
+		shell.SetAuthor("Bob", "bob@example.com")
+		shell.UpdateFileAndAdd("filterFile", "apple\nbanana\n")
+		shell.Commit("bob adds banana")
+		shell.CreateFileAndAdd("otherFile", "apple\n")
+		shell.Commit("bob adds apple elsewhere")
+
+		shell.SetAuthor("Alice", "alice@example.com")
+		shell.UpdateFileAndAdd("filterFile", "banana\n")
+		shell.Commit("alice removes apple")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter path to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter path:")).
+			Type("filterFile").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'filterFile'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("alice removes apple").IsSelected(),
+				Contains("bob adds banana"),
+				Contains("alice adds apple"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter author to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Author:")).
+			Type("Bob").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'filterFile', author 'Bob'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("bob adds banana").IsSelected(),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		// clear the author again
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter author to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Author:")).
+			InitialText(Equals("Bob")).
+			Clear().
+			Confirm()
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter text added or removed by the commits (-S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Text:")).
+			Type("apple").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'filterFile', content 'apple'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("alice removes apple").IsSelected(),
+				Contains("alice adds apple"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Stop filtering")).
+			Confirm()
+
+		t.Views().Information().Content(DoesNotContain("Filtering by"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("alice removes apple"),
+				Contains("bob adds apple elsewhere"),
+				Contains("bob adds banana"),
+				Contains("alice adds apple"),
+			)
+	},
+})
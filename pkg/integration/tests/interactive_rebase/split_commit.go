@@ -0,0 +1,118 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a commit into several commits, picking the changes for each one, and keeping its author",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("first commit")
+
+		shell.SetAuthor("Jane Smith", "jane@example.com")
+		shell.UpdateFileAndAdd("file1", "one\ntwo\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.CreateFileAndAdd("file3", "file3 content\n")
+		shell.Commit("commit to split")
+		shell.SetAuthor("CI", "CI@example.com")
+
+		shell.CreateFileAndAdd("file4", "file4 content\n")
+		shell.Commit("last commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("last commit").IsSelected(),
+				Contains("commit to split"),
+				Contains("first commit"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.SplitCommit)
+
+		t.Views().Information().Content(Contains("Splitting commit"))
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Title(Contains("commit to split")).
+			Lines(
+				Contains("M file1").IsSelected(),
+				Contains("A file2"),
+				Contains("A file3"),
+			).
+			Press(keys.CommitFiles.CommitSplitPart)
+
+		t.ExpectToast(Equals("Disabled: Add the changes for the new commit to the custom patch first."))
+
+		t.Views().CommitFiles().
+			PressPrimaryAction().
+			Press(keys.CommitFiles.CommitSplitPart)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("commit to split")).
+			Clear().
+			Type("first part").
+			Confirm()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("A file2").IsSelected(),
+				Contains("A file3"),
+			).
+			PressPrimaryAction().
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.CommitFiles.CommitSplitPart)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("commit to split")).
+			Clear().
+			Type("second part").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("CI last commit"),
+				Contains("JS second part"),
+				Contains("JS first part"),
+				Contains("CI first commit"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("Splitting commit"))
+
+		t.Git().CurrentBranchName("master")
+
+		t.Views().Commits().
+			NavigateToLine(Contains("first part")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1"),
+			).
+			PressEscape()
+
+		t.Views().Commits().
+			NavigateToLine(Contains("second part")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("A file2"),
+				Contains("A file3"),
+			)
+	},
+})
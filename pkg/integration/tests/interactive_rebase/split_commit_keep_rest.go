@@ -0,0 +1,90 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitCommitKeepRest = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split one part off a commit, then reset the split mode to keep the rest of the changes in one commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file0", "file0 content\n")
+		shell.Commit("first commit")
+
+		shell.CreateFileAndAdd("file1", "file1 content\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.CreateFileAndAdd("file3", "file3 content\n")
+		shell.Commit("commit to split")
+
+		shell.CreateFileAndAdd("file4", "file4 content\n")
+		shell.Commit("last commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("last commit").IsSelected(),
+				Contains("commit to split"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("first commit")).
+			Press(keys.Commits.SplitCommit)
+
+		t.ExpectToast(Equals("Disabled: Can't split the first commit of the repository."))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("commit to split")).
+			Press(keys.Commits.SplitCommit)
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("A file1").IsSelected(),
+				Contains("A file2"),
+				Contains("A file3"),
+			).
+			PressPrimaryAction().
+			Press(keys.CommitFiles.CommitSplitPart)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Clear().
+			Type("add file1").
+			Confirm()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("A file2").IsSelected(),
+				Contains("A file3"),
+			).
+			PressEscape()
+
+		t.Views().Information().Content(Contains("Splitting commit"))
+
+		t.Views().Commits().
+			IsFocused().
+			PressEscape().
+			Lines(
+				Contains("last commit"),
+				Contains("commit to split").IsSelected(),
+				Contains("add file1"),
+				Contains("first commit"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("Splitting commit"))
+		t.Views().Information().Content(DoesNotContain("Rebasing"))
+
+		t.Views().Commits().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("A file2"),
+				Contains("A file3"),
+			)
+	},
+})
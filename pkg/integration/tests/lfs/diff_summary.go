@@ -0,0 +1,79 @@
+package lfs
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+func lfsPointer(oidChar string, size string) string {
+	return "version https://git-lfs.github.com/spec/v1\n" +
+		"oid sha256:" + strings.Repeat(oidChar, 64) + "\n" +
+		"size " + size + "\n"
+}
+
+var DiffSummary = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a summary of the old and new objects instead of the pointer diff for LFS files",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// git-lfs isn't necessarily installed, so we commit the pointer files
+		// ourselves, which is what git stores for LFS files anyway
+		shell.CreateFileAndAdd(".gitattributes", "*.psd filter=lfs diff=lfs merge=lfs -text\n")
+		shell.CreateFileAndAdd("image.psd", lfsPointer("a", "2048"))
+		shell.CreateFileAndAdd("notes.txt", "some notes\n")
+		shell.Commit("add image")
+		shell.UpdateFileAndAdd("image.psd", lfsPointer("b", "3072"))
+		shell.Commit("update image")
+		shell.UpdateFile("image.psd", lfsPointer("c", "1048576"))
+		shell.UpdateFile("notes.txt", "more notes\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("image.psd (LFS)").IsSelected(),
+				Contains("notes.txt").DoesNotContain("LFS"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("Git LFS object: image.psd").
+					Contains("- old: sha256:" + strings.Repeat("b", 64) + " (3.0 KiB)").
+					Contains("+ new: sha256:" + strings.Repeat("c", 64) + " (1.0 MiB)").
+					DoesNotContain("version https://git-lfs.github.com/spec/v1"),
+			)
+
+		t.Views().Files().
+			PressPrimaryAction()
+
+		t.Views().Main().
+			Content(
+				Contains("- old: sha256:" + strings.Repeat("b", 64) + " (3.0 KiB)").
+					Contains("+ new: sha256:" + strings.Repeat("c", 64) + " (1.0 MiB)"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update image").IsSelected(),
+				Contains("add image"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("image.psd").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("Git LFS object: image.psd").
+					Contains("- old: sha256:" + strings.Repeat("a", 64) + " (2.0 KiB)").
+					Contains("+ new: sha256:" + strings.Repeat("b", 64) + " (3.0 KiB)"),
+			)
+	},
+})
@@ -0,0 +1,58 @@
+package lfs
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LargeFileWarning = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Warn before committing a large file that isn't tracked by LFS",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.LargeFileWarningSize = 1000
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitattributes", "*.psd filter=lfs diff=lfs merge=lfs -text\n")
+		shell.Commit("add attributes")
+		shell.CreateFileAndAdd("big.bin", strings.Repeat("x", 2000))
+		shell.CreateFileAndAdd("big.psd", strings.Repeat("x", 2000))
+		shell.CreateFileAndAdd("small.txt", "small")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Large files")).
+			Content(
+				Contains("larger than 1000 B").
+					Contains("big.bin").
+					DoesNotContain("big.psd").
+					DoesNotContain("small.txt"),
+			).
+			Cancel()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Large files")).
+			Content(Contains("big.bin")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("add files").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("add files"),
+				Contains("add attributes"),
+			)
+	},
+})
@@ -0,0 +1,23 @@
+package lfs
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MenuDisabledWithoutLfs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "The LFS menu is disabled in repos that don't use LFS",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file.txt", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.OpenLfsMenu)
+
+		t.ExpectToast(Equals("Disabled: This repository doesn't use Git LFS."))
+	},
+})
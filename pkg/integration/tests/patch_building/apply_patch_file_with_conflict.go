@@ -0,0 +1,67 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyPatchFileWithConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a patch file that doesn't apply cleanly, falling back to a three-way merge",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n")
+		shell.Commit("first commit")
+
+		shell.CreateFile("file", "1\ntwo\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\neighteen\n19\n20\n")
+		shell.RunShellCommand("git diff > ../fix.patch")
+		shell.Checkout("file")
+
+		shell.UpdateFileAndAdd("file", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\nEIGHTEEN\n19\n20\n")
+		shell.Commit("conflicting")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty()
+
+		t.GlobalPress(keys.Universal.CreatePatchOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch options")).
+			// without a custom patch, we can only apply one from elsewhere
+			Lines(
+				Contains("y Copy patch to clipboard"),
+				Contains("s Save patch to file"),
+				Contains("l Apply patch from file"),
+				Contains("v Apply patch from clipboard"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Apply patch from file")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Patch file to apply:")).
+			Type("../fix.patch").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Apply patch to")).
+			Select(MatchesRegexp(`Working tree$`)).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Patch doesn't apply cleanly")).
+			Content(Contains("don't apply to the current version of the files:\n\nfile:15\n\n")).
+			Confirm()
+
+		t.ExpectToast(Equals("Patch applied with conflicts. Resolve them in the files panel."))
+
+		t.Views().Files().
+			Lines(
+				Contains("UU file"),
+			)
+
+		t.FileSystem().FileContent("file", Contains("1\ntwo\n3").Contains("<<<<<<< ours\nEIGHTEEN\n=======\neighteen\n>>>>>>> theirs"))
+	},
+})
@@ -0,0 +1,48 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the clipboard by reading from a file called clipboard
+
+var ApplyPatchFromClipboard = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a patch from the clipboard to the working tree and index",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.OS.ReadFromClipboardCmd = "cat ../clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "first line\n")
+		shell.Commit("first commit")
+
+		shell.CreateFile("file", "first line\nsecond line\n")
+		shell.RunShellCommand("git diff > ../clipboard")
+		shell.Checkout("file")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty()
+
+		t.GlobalPress(keys.Universal.CreatePatchOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch options")).
+			Select(Contains("Apply patch from clipboard")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Apply patch to")).
+			Select(Contains("Working tree and index")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("M  file"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("first line\nsecond line\n"))
+	},
+})
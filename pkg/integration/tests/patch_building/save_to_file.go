@@ -0,0 +1,51 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SaveToFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Save a custom patch to a file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.CreateFileAndAdd("file2", "content\n")
+		shell.Commit("update")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1").IsSelected(),
+				Contains("A file2"),
+			).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("Building patch"))
+
+		t.Common().SelectPatchOption(Contains("Save patch to file"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Save patch to:")).
+			Type("../fix.patch").
+			Confirm()
+
+		t.ExpectToast(Equals("Saved patch to '../fix.patch'"))
+
+		t.FileSystem().FileContent("../fix.patch",
+			Contains("+++ b/file1").Contains("+second line").DoesNotContain("file2"))
+	},
+})
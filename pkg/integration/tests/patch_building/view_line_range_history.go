@@ -0,0 +1,68 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewLineRangeHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the history of the selected lines from the patch building panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\nfour\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file", "one\nTWO\nthree\nfour\n")
+		shell.Commit("change two")
+		shell.UpdateFileAndAdd("file", "one\nTWO\nthree\nFOUR\n")
+		shell.Commit("change four")
+		shell.UpdateFileAndAdd("file", "one\nTWO\nthree\nFOUR!\n")
+		shell.Commit("change four again")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("change four again").IsSelected(),
+				Contains("change four"),
+				Contains("change two"),
+				Contains("add file"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLine(Contains("-four")).
+			SelectNextItem().
+			SelectedLine(Contains("+FOUR")).
+			Press(keys.Main.ViewLineRangeHistory)
+
+		// The history starts at the commit we're looking at, so the later
+		// commit is not included
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Contains("file:4-4")).
+			Lines(
+				Contains("change four").IsSelected(),
+				Contains("add file"),
+			)
+
+		t.Views().Main().
+			Content(Contains("-four").Contains("+FOUR"))
+
+		t.Views().SubCommits().
+			PressEscape()
+
+		t.Views().CommitFiles().
+			IsFocused()
+	},
+})
@@ -157,3 +157,22 @@ var CreateMergeConflictFileMultiple = func(shell *Shell) {
 
 	shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
 }
+
+var RerereResolvedFileContent = `
+This
+Is
+The
+Resolved
+File
+`
+
+// resolves the conflict once so that rerere records the resolution, and then
+// runs into the same conflict again
+var CreateMergeConflictFileResolvedByRerere = func(shell *Shell) {
+	shell.SetConfig("rerere.enabled", "true")
+	CreateMergeConflictFile(shell)
+	shell.UpdateFileAndAdd("file", RerereResolvedFileContent)
+	shell.ContinueMerge()
+	shell.HardReset("HEAD~1")
+	shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
+}
@@ -0,0 +1,65 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddAndRemove = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add a directory to the sparse checkout and remove another one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.32.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		createDirectories(shell)
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "backend"})
+		shell.CreateFile("docs/draft.md", "draft\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("docs"),
+				Contains("draft.md (outside sparse checkout)"),
+			)
+
+		t.Views().SparseCheckout().
+			Focus().
+			Lines(
+				Contains("backend").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("backend/server.go"))
+			}).
+			Press(keys.Universal.New).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Directory to add to the sparse checkout:")).
+					Type("fronte").
+					SuggestionLines(Contains("frontend")).
+					ConfirmFirstSuggestion()
+			}).
+			Lines(
+				Contains("backend").IsSelected(),
+				Contains("frontend"),
+			).
+			Tap(func() {
+				t.FileSystem().PathPresent("frontend/app.js")
+				t.FileSystem().PathNotPresent("docs/guide.md")
+			}).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Remove directory from sparse checkout")).
+					Content(Equals("Are you sure you want to remove 'backend' from the sparse checkout? Its files will be removed from the working tree.")).
+					Confirm()
+			}).
+			Lines(
+				Contains("frontend").IsSelected(),
+			)
+
+		t.FileSystem().PathNotPresent("backend/server.go")
+		t.FileSystem().PathPresent("frontend/app.js")
+	},
+})
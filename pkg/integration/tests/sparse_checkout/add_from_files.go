@@ -0,0 +1,50 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddFromFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add the directory of a file that is outside of the sparse checkout from the files view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.32.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		createDirectories(shell)
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "backend"})
+		shell.CreateFile("docs/draft.md", "draft\n")
+		shell.CreateFile("notes.txt", "notes\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("docs").IsSelected(),
+				Contains("draft.md (outside sparse checkout)"),
+				Contains("notes.txt"),
+			).
+			NavigateToLine(Contains("notes.txt")).
+			Press(keys.Files.AddToSparseCheckout).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: Files in the root directory are always checked out."))
+			}).
+			NavigateToLine(Contains("draft.md")).
+			Press(keys.Files.AddToSparseCheckout).
+			Lines(
+				Contains("docs"),
+				Contains("draft.md").DoesNotContain("outside sparse checkout").IsSelected(),
+				Contains("notes.txt"),
+			)
+
+		t.FileSystem().PathPresent("docs/guide.md")
+		t.FileSystem().PathNotPresent("frontend/app.js")
+
+		t.Views().SparseCheckout().
+			Lines(
+				Contains("backend"),
+				Contains("docs"),
+			)
+	},
+})
@@ -0,0 +1,46 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DisableAndReapply = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Disable the sparse checkout and reapply it afterwards",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.32.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		createDirectories(shell)
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "backend", "docs"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().SparseCheckout().
+			Focus().
+			Lines(
+				Contains("backend").IsSelected(),
+				Contains("docs"),
+			).
+			Press(keys.SparseCheckout.Disable).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Disable sparse checkout")).
+					Content(Contains("Check out all files again.")).
+					Confirm()
+			}).
+			IsEmpty().
+			Tap(func() {
+				t.Views().Main().Content(Contains("This worktree is not a sparse checkout, so all files are checked out."))
+				t.FileSystem().PathPresent("frontend/app.js")
+			}).
+			Press(keys.SparseCheckout.Reapply).
+			Lines(
+				Contains("backend").IsSelected(),
+				Contains("docs"),
+			)
+
+		t.FileSystem().PathNotPresent("frontend/app.js")
+		t.FileSystem().PathPresent("docs/guide.md")
+	},
+})
@@ -0,0 +1,13 @@
+package sparse_checkout
+
+import (
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+func createDirectories(shell *Shell) {
+	shell.CreateFileAndAdd("README.md", "readme\n")
+	shell.CreateFileAndAdd("backend/server.go", "package main\n")
+	shell.CreateFileAndAdd("docs/guide.md", "guide\n")
+	shell.CreateFileAndAdd("frontend/app.js", "app\n")
+	shell.Commit("initial commit")
+}
@@ -0,0 +1,92 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitHunk = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a hunk into smaller hunks and stage them one at a time",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n")
+		shell.Commit("one")
+
+		// the changes are close enough together for git to put them in a single hunk
+		shell.UpdateFile("file1", "1a\n2b\n3a\n4b\n5a\n6b\n7a\n8a\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleSelectHunk).
+			SelectedLines(
+				Contains(`@@ -1,8 +1,8 @@`),
+				Contains(` 1a`),
+				Contains(`-2a`),
+				Contains(`+2b`),
+				Contains(` 3a`),
+				Contains(`-4a`),
+				Contains(`+4b`),
+				Contains(` 5a`),
+				Contains(`-6a`),
+				Contains(`+6b`),
+				Contains(` 7a`),
+				Contains(` 8a`),
+			).
+			Press(keys.Main.SplitHunk).
+			SelectedLines(
+				Contains(`@@ -1,8 +1,8 @@`),
+				Contains(` 1a`),
+				Contains(`-2a`),
+				Contains(`+2b`),
+				Contains(` 3a`),
+			).
+			// a hunk can only be split once
+			Press(keys.Main.SplitHunk).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: This hunk can't be split any further"))
+			}).
+			SelectNextItem().
+			SelectedLines(
+				Contains(`-4a`),
+				Contains(`+4b`),
+				Contains(` 5a`),
+			).
+			PressPrimaryAction().
+			// the rest of the hunk stays split after staging a piece of it, with
+			// the unchanged lines between the remaining changes shared out
+			SelectedLines(
+				Contains(` 5a`),
+				Contains(`-6a`),
+				Contains(`+6b`),
+				Contains(` 7a`),
+				Contains(` 8a`),
+			).
+			SelectPreviousItem().
+			SelectedLines(
+				Contains(`@@ -1,8 +1,8 @@`),
+				Contains(` 1a`),
+				Contains(`-2a`),
+				Contains(`+2b`),
+				Contains(` 3a`),
+				Contains(` 4b`),
+			).
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			ContainsLines(
+				Contains(`-4a`),
+				Contains(`+4b`),
+			)
+	},
+})
@@ -0,0 +1,115 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageHunksOneByOne = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Step through the hunks of all unstaged files, staging some and skipping others",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n")
+		// need to be working with a few lines so that git perceives it as two separate hunks
+		shell.CreateFileAndAdd("file2", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n13a\n14a\n15a\n")
+		shell.CreateFileAndAdd("file3", "1a\n2a\n3a\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1a\n2b\n3a\n")
+		shell.UpdateFile("file2", "1a\n2a\n3b\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n13b\n14a\n15a\n")
+		shell.UpdateFile("file3", "1a\n2a\n3b\n")
+		// untracked files are left out, like with `git add -p`
+		shell.CreateFile("untracked", "new\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+				Contains("file3"),
+				Contains("untracked"),
+			).
+			Press(keys.Files.StageHunksOneByOne)
+
+		t.Views().Staging().
+			IsFocused().
+			Title(Equals("Unstaged changes")).
+			SelectedLines(
+				Contains(`@@ -1,3 +1,3 @@`),
+				Contains(` 1a`),
+				Contains(`-2a`),
+				Contains(`+2b`),
+				Contains(` 3a`),
+			).
+			// staging the only hunk of file1 takes us to file2
+			PressPrimaryAction()
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2").IsSelected(),
+				Contains("file3"),
+				Contains("untracked"),
+			)
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains(`@@ -1,6 +1,6 @@`),
+				Contains(` 1a`),
+				Contains(` 2a`),
+				Contains(`-3a`),
+				Contains(`+3b`),
+				Contains(` 4a`),
+				Contains(` 5a`),
+				Contains(` 6a`),
+			).
+			Press(keys.Main.SkipHunk).
+			SelectedLines(
+				Contains(`@@ -10,6 +10,6 @@`),
+				Contains(` 10a`),
+				Contains(` 11a`),
+				Contains(` 12a`),
+				Contains(`-13a`),
+				Contains(`+13b`),
+				Contains(` 14a`),
+				Contains(` 15a`),
+			).
+			// this was the last hunk of file2, so we move on even though we
+			// skipped the first one
+			PressPrimaryAction()
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2"),
+				Contains("file3").IsSelected(),
+				Contains("untracked"),
+			)
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains(`@@ -1,3 +1,3 @@`),
+				Contains(` 1a`),
+				Contains(` 2a`),
+				Contains(`-3a`),
+				Contains(`+3b`),
+			).
+			Press(keys.Main.SkipHunk)
+
+		t.ExpectToast(Equals("No more hunks to stage"))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("M  file1"),
+				Equals("MM file2"),
+				Equals(" M file3").IsSelected(),
+				Equals("?? untracked"),
+			)
+	},
+})
@@ -0,0 +1,64 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewLineRangeHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the history of the selected lines from the staging panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\nfour\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file", "one\nTWO\nthree\nfour\n")
+		shell.Commit("change two")
+		shell.CreateFileAndAdd("other", "content")
+		shell.Commit("unrelated")
+		shell.UpdateFileAndAdd("file", "one\nTWO\nthree\nFOUR\n")
+		shell.Commit("change four")
+		shell.UpdateFile("file", "ONE\nTWO\nthree\nFOUR\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLine(Contains("-one")).
+			SelectNextItem().
+			SelectNextItem().
+			SelectedLine(Contains(" TWO")).
+			Press(keys.Main.ViewLineRangeHistory)
+
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Contains("file:2-2")).
+			Lines(
+				Contains("change two").IsSelected(),
+				Contains("add file"),
+			)
+
+		t.Views().Main().
+			Content(Contains("-two").Contains("+TWO")).
+			Content(DoesNotContain("FOUR"))
+
+		t.Views().SubCommits().
+			SelectNextItem()
+
+		t.Views().Main().
+			Content(Contains("+two"))
+
+		t.Views().SubCommits().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,57 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BranchFromStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a new branch at the base of a stash entry and apply the stash entry there",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file", "stashed content")
+		shell.Stash("stash one")
+		shell.UpdateFileAndAdd("file", "later content")
+		shell.Commit("later commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.BranchFromStash)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("New branch name (checked out at the base of 'stash@{0}: On master: stash one')")).
+			Type("new branch").
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("new-branch").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("initial commit"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file"),
+			)
+
+		t.Views().Stash().IsEmpty()
+
+		t.FileSystem().FileContent("file", Equals("stashed content"))
+	},
+})
@@ -0,0 +1,56 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the untracked files of a stash entry, and diff a stash entry against a commit and the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.32.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file", "stashed content\n")
+		shell.CreateFile("untracked-file", "untracked content\n")
+		shell.RunCommand([]string{"git", "stash", "push", "--include-untracked", "-m", "stash one"})
+		shell.UpdateFile("file", "working tree content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("+stashed content").Contains("untracked-file").Contains("+untracked content"))
+			}).
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Diffing")).Select(Contains("Diff stash@{0}")).Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("initial commit").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Information().Content(Contains("Showing output for: git diff stash@{0}"))
+				t.Views().Main().Content(Contains("-stashed content").Contains("+content"))
+			})
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains(" M file").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Information().Content(Contains("Showing output for: git diff stash@{0} -- file"))
+				t.Views().Main().Content(Contains("-stashed content").Contains("+working tree content"))
+			})
+	},
+})
@@ -0,0 +1,58 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash a range selection of files and directories, leaving the other changes in place",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/file-a", "content")
+		shell.CreateFileAndAdd("file-b", "content")
+		shell.CreateFileAndAdd("file-c", "content")
+		shell.Commit("initial commit")
+		shell.UpdateFile("dir/file-a", "new content")
+		shell.CreateFile("dir/untracked", "new content")
+		shell.UpdateFileAndAdd("file-b", "new content")
+		shell.UpdateFile("file-c", "new content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsEmpty()
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M file-a"),
+				Contains("?? untracked"),
+				Contains("M  file-b"),
+				Contains(" M file-c"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash selected files")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my selected files").Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("my selected files"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file-c"),
+			)
+
+		t.FileSystem().PathNotPresent("dir/untracked")
+		t.FileSystem().FileContent("file-b", Equals("content"))
+	},
+})
@@ -0,0 +1,87 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash selected unstaged and staged lines from the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\ntwo\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\nstash me\ntwo\nkeep me\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+stash me")).
+			Press(keys.Main.StashSelection).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("unstaged lines").Confirm()
+			}).
+			Content(DoesNotContain("+stash me")).
+			SelectedLines(Contains("+keep me")).
+			// stage 'keep me' so that we can stash it from the staged changes
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			SelectedLines(Contains("+keep me")).
+			Press(keys.Main.StashSelection).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("staged lines").Confirm()
+			})
+
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+
+		t.FileSystem().FileContent("file1", Equals("one\ntwo\n"))
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("staged lines").IsSelected(),
+				Contains("unstaged lines"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+keep me").DoesNotContain("stash me"))
+
+		t.Views().CommitFiles().
+			PressEscape()
+
+		t.Views().Stash().
+			IsFocused().
+			NavigateToLine(Contains("unstaged lines")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+stash me").DoesNotContain("keep me"))
+	},
+})
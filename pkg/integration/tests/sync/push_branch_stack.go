@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushBranchStack = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push all branches of a stack after rewriting it, force-pushing those that have diverged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowBranchStackNames = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("part-1").
+			EmptyCommit("part 1").
+			CloneIntoRemote("origin").
+			SetBranchUpstream("part-1", "origin/part-1").
+			RunCommand([]string{"git", "commit", "--amend", "--allow-empty", "-m", "part 1 amended"}).
+			NewBranch("part-2").
+			EmptyCommit("part 2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("part 2").IsSelected(),
+				Contains("* part-1 part 1 amended"),
+				Contains("base"),
+			).
+			Press(keys.Commits.OpenBranchStackMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branch stack")).
+			Select(Contains("Push all branches of the stack")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Push branch stack")).
+			Content(
+				Contains("✓ part-1 → origin/part-1").
+					Contains("✓ part-2 → origin/part-2"),
+			).
+			Confirm()
+
+		t.Views().Remotes().
+			Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("part-1"),
+				Contains("part-2"),
+			).
+			NavigateToLine(Contains("part-1")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("part 1 amended"),
+				Contains("base"),
+			)
+	},
+})
@@ -17,9 +17,11 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_and_search"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/lfs"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/sparse_checkout"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/submodule"
@@ -34,6 +36,8 @@ var tests = []*components.IntegrationTest{
 	bisect.Basic,
 	bisect.ChooseTerms,
 	bisect.FromOtherBranch,
+	bisect.Run,
+	bisect.SaveAndReplayLog,
 	bisect.Skip,
 	branch.CheckoutByName,
 	branch.CreateTag,
@@ -42,13 +46,18 @@ var tests = []*components.IntegrationTest{
 	branch.DetachedHead,
 	branch.OpenPullRequestNoUpstream,
 	branch.OpenWithCliArg,
+	branch.PreviewConflicts,
+	branch.RangeDiff,
 	branch.Rebase,
 	branch.RebaseAbortOnConflict,
 	branch.RebaseAndDrop,
 	branch.RebaseCancelOnConflict,
 	branch.RebaseDoesNotAutosquash,
 	branch.RebaseFromMarkedBase,
+	branch.RebaseStack,
 	branch.RebaseToUpstream,
+	branch.RebaseWithConflictWarning,
+	branch.RebaseWithoutConflictWarning,
 	branch.Rename,
 	branch.Reset,
 	branch.ResetToUpstream,
@@ -62,21 +71,32 @@ var tests = []*components.IntegrationTest{
 	cherry_pick.CherryPickConflicts,
 	cherry_pick.CherryPickDuringRebase,
 	cherry_pick.CherryPickRange,
+	commit.Absorb,
 	commit.AddCoAuthor,
+	commit.AddTrailers,
 	commit.Amend,
+	commit.ApplyPatches,
 	commit.Commit,
 	commit.CommitMultiline,
 	commit.CommitSwitchToEditor,
 	commit.CommitWipWithPrefix,
 	commit.CommitWithPrefix,
+	commit.CommitWithTemplate,
+	commit.ConventionalCommit,
 	commit.CreateTag,
 	commit.DiscardOldFileChange,
+	commit.ExportPatches,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupWarningForAddedLines,
 	commit.Highlight,
 	commit.History,
 	commit.HistoryComplex,
+	commit.LintCommitMessage,
+	commit.MoveCommitsToBranchWithConflict,
+	commit.MoveCommitsToExistingBranch,
+	commit.MoveCommitsToNewBranch,
 	commit.NewBranch,
+	commit.Notes,
 	commit.PreserveCommitMessage,
 	commit.ResetAuthor,
 	commit.Revert,
@@ -84,14 +104,21 @@ var tests = []*components.IntegrationTest{
 	commit.Reword,
 	commit.Search,
 	commit.SetAuthor,
+	commit.SignatureStatus,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
 	commit.Unstaged,
 	config.RemoteNamedStar,
 	conflicts.Filter,
+	conflicts.MergeEditor,
+	conflicts.MergeEditorShowBase,
+	conflicts.RerereBrowseResolutions,
+	conflicts.RerereForget,
+	conflicts.RerereResolved,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
+	conflicts.ResolveWholeFileConflicts,
 	conflicts.UndoChooseHunk,
 	custom_commands.BasicCmdAtRuntime,
 	custom_commands.BasicCmdFromConfig,
@@ -123,6 +150,7 @@ var tests = []*components.IntegrationTest{
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.IgnoreWhitespace,
+	file.Blame,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
 	file.DiscardAllDirChanges,
@@ -145,6 +173,7 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
 	filter_by_path.CliArg,
+	filter_by_path.CombineWithOtherCriteria,
 	filter_by_path.SelectFile,
 	filter_by_path.TypeFile,
 	interactive_rebase.AdvancedInteractiveRebase,
@@ -174,12 +203,17 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.RewordLastCommit,
 	interactive_rebase.RewordYouAreHereCommit,
 	interactive_rebase.RewordYouAreHereCommitWithEditor,
+	interactive_rebase.SplitCommit,
+	interactive_rebase.SplitCommitKeepRest,
 	interactive_rebase.SquashDownFirstCommit,
 	interactive_rebase.SquashDownSecondCommit,
 	interactive_rebase.SquashFixupsAboveFirstCommit,
 	interactive_rebase.SwapInRebaseWithConflict,
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
+	lfs.DiffSummary,
+	lfs.LargeFileWarning,
+	lfs.MenuDisabledWithoutLfs,
 	misc.ConfirmOnQuit,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
@@ -188,6 +222,8 @@ var tests = []*components.IntegrationTest{
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
 	patch_building.ApplyInReverseWithConflict,
+	patch_building.ApplyPatchFileWithConflict,
+	patch_building.ApplyPatchFromClipboard,
 	patch_building.MoveToEarlierCommit,
 	patch_building.MoveToEarlierCommitNoKeepEmpty,
 	patch_building.MoveToIndex,
@@ -200,23 +236,33 @@ var tests = []*components.IntegrationTest{
 	patch_building.MoveToNewCommitPartialHunk,
 	patch_building.RemoveFromCommit,
 	patch_building.ResetWithEscape,
+	patch_building.SaveToFile,
 	patch_building.SelectAllFiles,
 	patch_building.SpecificSelection,
 	patch_building.StartNewPatch,
+	patch_building.ViewLineRangeHistory,
 	reflog.Checkout,
 	reflog.CherryPick,
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
 	reflog.Patch,
 	reflog.Reset,
+	sparse_checkout.AddAndRemove,
+	sparse_checkout.AddFromFiles,
+	sparse_checkout.DisableAndReapply,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
 	staging.Search,
+	staging.SplitHunk,
 	staging.StageHunks,
+	staging.StageHunksOneByOne,
 	staging.StageLines,
 	staging.StageRanges,
+	staging.ViewLineRangeHistory,
 	stash.Apply,
 	stash.ApplyPatch,
+	stash.BranchFromStash,
 	stash.CreateBranch,
+	stash.DiffStash,
 	stash.Drop,
 	stash.Pop,
 	stash.PreventDiscardingFileChanges,
@@ -225,6 +271,8 @@ var tests = []*components.IntegrationTest{
 	stash.StashAll,
 	stash.StashAndKeepIndex,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedFiles,
+	stash.StashSelectedLines,
 	stash.StashStaged,
 	stash.StashUnstaged,
 	submodule.Add,
@@ -247,6 +295,7 @@ var tests = []*components.IntegrationTest{
 	sync.Push,
 	sync.PushAndAutoSetUpstream,
 	sync.PushAndSetUpstream,
+	sync.PushBranchStack,
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
 	sync.PushTag,
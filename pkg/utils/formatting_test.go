@@ -245,3 +245,21 @@ func TestRenderDisplayStrings(t *testing.T) {
 		assert.EqualValues(t, test.expectedColumnPositions, columnPositions)
 	}
 }
+
+func TestFormatBytes(t *testing.T) {
+	scenarios := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{50 * 1024 * 1024, "50.0 MiB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GiB"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, FormatBytes(s.size))
+	}
+}
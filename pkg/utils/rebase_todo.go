@@ -214,6 +214,44 @@ func moveFixupCommitDown(todos []todo.Todo, originalSha string, fixupSha string)
 	return newTodos, nil
 }
 
+func MoveCommitsToBranch(fileName string, shas []string, branchName string, branchSha string, baseSha string, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	newTodos, err := moveCommitsToBranch(todos, shas, branchName, branchSha, baseSha)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+// Picks the given commits (oldest first) on top of branchSha and points the
+// branch at the result, then goes back to baseSha for the rest of the todos.
+// We use an update-ref todo for this so that git only moves the branch once
+// the whole rebase has succeeded.
+func moveCommitsToBranch(todos []todo.Todo, shas []string, branchName string, branchSha string, baseSha string) ([]todo.Todo, error) {
+	isCommitToMove := func(t todo.Todo) bool {
+		return t.Command == todo.Pick && lo.SomeBy(shas, func(sha string) bool { return equalShas(t.Commit, sha) })
+	}
+
+	commitsToMove := lo.Filter(todos, func(t todo.Todo, _ int) bool { return isCommitToMove(t) })
+	if len(commitsToMove) != len(shas) {
+		return nil, fmt.Errorf("Expected %d commits to move, found %d", len(shas), len(commitsToMove))
+	}
+
+	newTodos := []todo.Todo{{Command: todo.Reset, Label: branchSha}}
+	newTodos = append(newTodos, commitsToMove...)
+	newTodos = append(newTodos,
+		todo.Todo{Command: todo.UpdateRef, Ref: "refs/heads/" + branchName},
+		todo.Todo{Command: todo.Reset, Label: baseSha},
+	)
+
+	return append(newTodos, lo.Filter(todos, func(t todo.Todo, _ int) bool { return !isCommitToMove(t) })...), nil
+}
+
 // We render a todo in the commits view if it's a commit or if it's an
 // update-ref. We don't render label, reset, or comment lines.
 func isRenderedTodo(t todo.Todo) bool {
@@ -330,3 +330,77 @@ func TestRebaseCommands_moveFixupCommitDown(t *testing.T) {
 		})
 	}
 }
+
+func TestRebaseCommands_moveCommitsToBranch(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		shas          []string
+		expectedTodos []todo.Todo
+		expectedErr   error
+	}{
+		{
+			name: "move the oldest commits",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Pick, Commit: "abcd"},
+			},
+			shas: []string{"1234", "5678"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Reset, Label: "branchsha"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.UpdateRef, Ref: "refs/heads/other"},
+				{Command: todo.Reset, Label: "basesha"},
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Pick, Commit: "abcd"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "keep other update-refs in place",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.UpdateRef, Ref: "refs/heads/stacked"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			shas: []string{"1234"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Reset, Label: "branchsha"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.UpdateRef, Ref: "refs/heads/other"},
+				{Command: todo.Reset, Label: "basesha"},
+				{Command: todo.UpdateRef, Ref: "refs/heads/stacked"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "commit to move not found",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			shas:          []string{"1234", "5678"},
+			expectedTodos: nil,
+			expectedErr:   errors.New("Expected 2 commits to move, found 1"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos, actualErr := moveCommitsToBranch(scenario.todos, scenario.shas, "other", "branchsha", "basesha")
+
+			if scenario.expectedErr == nil {
+				assert.NoError(t, actualErr)
+			} else {
+				assert.EqualError(t, actualErr, scenario.expectedErr.Error())
+			}
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}